@@ -0,0 +1,117 @@
+package dotnet
+
+/*
+#include <stdlib.h>
+
+// loadAssemblyArgs is passed to GoDotnet.Host.LoadAssembly.
+typedef struct loadAssemblyArgs {
+  const char* name;
+  void* image;
+  int imageSize;
+  void* symbols;
+  int symbolsSize;
+} loadAssemblyArgs;
+*/
+import "C"
+
+import (
+	"fmt"
+	"io/fs"
+	"math"
+	"path"
+	"strings"
+	"unsafe"
+)
+
+// LoadOption customizes a single LoadAssemblyFromBytes call.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	symbols []byte
+}
+
+// WithSymbols loads the portable PDB symbols of the assembly too, the stack traces of its exceptions
+// get file names and line numbers.
+func WithSymbols(pdb []byte) LoadOption {
+	return func(o *loadOptions) {
+		o.symbols = pdb
+	}
+}
+
+// LoadAssemblyFromBytes loads an assembly image from memory into the default AssemblyLoadContext,
+// name must be its simple name, like "Test". The assembly is used like the ones found in APP_PATHS
+// afterwards: NewDelegate, Bind, New and CallAsync find it by name, and so do the assemblies that
+// reference it. An assembly is loaded once, and stays loaded until the runtime shuts down.
+func (r *Runtime) LoadAssemblyFromBytes(name string, image []byte, options ...LoadOption) error {
+	release, err := r.checkRunning(OpLoadAssembly)
+	if err != nil {
+		return err
+	}
+	defer release()
+	var o loadOptions
+	for _, option := range options {
+		option(&o)
+	}
+	if len(image) == 0 || len(image) > math.MaxInt32 || len(o.symbols) > math.MaxInt32 {
+		return fmt.Errorf("%w: %s has a %d bytes image", ErrInvalidArgument, name, len(image))
+	}
+	loadAssembly, err := r.helperFunction("LoadAssembly")
+	if err != nil {
+		return err
+	}
+
+	// The images are copied, C memory can't point to Go memory.
+	args := C.loadAssemblyArgs{
+		name:      C.CString(name),
+		image:     C.CBytes(image),
+		imageSize: C.int(len(image)),
+	}
+	defer C.free(unsafe.Pointer(args.name))
+	defer C.free(args.image)
+	if o.symbols != nil {
+		args.symbols = C.CBytes(o.symbols)
+		args.symbolsSize = C.int(len(o.symbols))
+		defer C.free(args.symbols)
+	}
+	if err := callHelper(OpLoadAssembly, loadAssembly, unsafe.Pointer(&args), unsafe.Sizeof(args)); err != nil {
+		r.log(LevelError, "Can't load the assembly", Fields{"assembly": name, "error": err.Error()})
+		return err
+	}
+	r.log(LevelDebug, "Loaded an assembly from memory", Fields{"assembly": name, "size": len(image)})
+	return nil
+}
+
+// LoadAssembliesFromFS loads the assemblies of fsys matching pattern, see fs.Glob, with LoadAssemblyFromBytes.
+// It's meant for the assemblies embedded in the Go binary:
+//
+//	//go:embed managed/*.dll managed/*.pdb
+//	var managed embed.FS
+//
+//	err := runtime.LoadAssembliesFromFS(managed, "managed/*.dll")
+//
+// Every assembly is named after its file, without the .dll extension, and gets the symbols of the .pdb
+// file next to it when there's one. The assemblies can reference each other, they're resolved when used.
+func (r *Runtime) LoadAssembliesFromFS(fsys fs.FS, pattern string) error {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("%w: no assembly matches %s", ErrAssemblyNotFound, pattern)
+	}
+	for _, file := range files {
+		image, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		base := strings.TrimSuffix(file, path.Ext(file))
+		var options []LoadOption
+		if symbols, err := fs.ReadFile(fsys, base+".pdb"); err == nil {
+			options = append(options, WithSymbols(symbols))
+		}
+		if err := r.LoadAssemblyFromBytes(path.Base(base), image, options...); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return nil
+}
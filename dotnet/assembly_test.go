@@ -0,0 +1,63 @@
+package dotnet
+
+import (
+	"embed"
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// embeddedAssemblies holds Embedded.dll, which references EmbeddedUtil.dll, and their symbols.
+// They aren't in APP_PATHS.
+//
+//go:embed testfiles/embedded
+var embeddedAssemblies embed.FS
+
+func TestLoadAssemblyFromBytes(t *testing.T) {
+	if _, err := Bind[func(int32) int32](testRuntime, "Embedded", "Embedded.Entry", "Quadruple"); !errors.Is(err, ErrAssemblyNotFound) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := testRuntime.LoadAssembliesFromFS(embeddedAssemblies, "testfiles/embedded/*.dll"); err != nil {
+		t.Fatal(err)
+	}
+	quadruple, err := Bind[func(int32) int32](testRuntime, "Embedded", "Embedded.Entry", "Quadruple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := quadruple(3); n != 12 {
+		t.Fatalf("Got %d", n)
+	}
+	var f unsafe.Pointer
+	if err := testRuntime.CreateDelegate("Embedded", "Embedded.Entry", "Quadruple", 0, &f); err != nil || f == nil {
+		t.Fatalf("Got %v, %v", f, err)
+	}
+	fail, err := Bind[func(int32) (int32, error)](testRuntime, "Embedded", "Embedded.Entry", "Fail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e *ManagedException
+	if _, err := fail(1); !errors.As(err, &e) || !strings.Contains(e.StackTrace, "Embedded.cs:line") {
+		t.Fatalf("Got %v, stack trace %q", err, e.StackTrace)
+	}
+
+	image, err := embeddedAssemblies.ReadFile("testfiles/embedded/Embedded.dll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testRuntime.LoadAssemblyFromBytes("Embedded", image); !errors.Is(err, ErrFileLoadException) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := testRuntime.LoadAssemblyFromBytes("Other", image); !errors.Is(err, ErrFileLoadException) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := testRuntime.LoadAssemblyFromBytes("Other", []byte("MZ")); !errors.Is(err, ErrBadImageFormat) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := testRuntime.LoadAssemblyFromBytes("Other", nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := testRuntime.LoadAssembliesFromFS(embeddedAssemblies, "testfiles/*.dll"); !errors.Is(err, ErrAssemblyNotFound) {
+		t.Fatalf("Got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package dotnet
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+// Bind creates a delegate for a static managed method and returns it as a Go function of type F:
+//
+//	add, err := dotnet.Bind[func(int32, int32) int32](rt, "Test", "Test.TestClass", "Add")
+//
+// F must be a non variadic function with up to MaxDelegateArity parameters and at most one result.
+// Parameters and results can be (or be defined as) int8, int16, int32, int64, uint8, uint16, uint32, uint64,
+// uintptr, float32, float64, unsafe.Pointer, string or UTF16String, strings follow the NewDelegate rules.
+// int and uint aren't accepted, their size doesn't match the .NET types on every platform.
+// F is checked against the managed method when Bind is called, see NewDelegate.
+//
+// Parameters can also be blittable structs, passed by value or as pointers (ref, in, out or unmanaged
+// pointers on the managed side), and the result a struct returned by value, see Structs in the README.
+// Their layout is checked against the managed structs when Bind is called.
+//
+// Slices of the integer and floating point types above are passed without copying, as a pointer to their first
+// element and an int32 length, which managed code can wrap in a Span<T>: []byte matches (IntPtr data, int length)
+// or (byte* data, int length). Managed code can write to the slice, it must not keep the pointer after the call.
+// The slices, structs and pointers passed to managed code are pinned with a runtime.Pinner during the call.
+// Each slice takes two of the MaxDelegateArity parameters.
+//
+// F can have an extra error result, like func(string) (int32, error), that receives the *ManagedException
+// thrown by the method. Without it, the function panics with the *ManagedException.
+func Bind[F any](r *Runtime, assembly, typ, method string) (F, error) {
+	var fn F
+	t := reflect.TypeOf(&fn).Elem()
+	sig, err := funcSignature(t)
+	if err != nil {
+		return fn, err
+	}
+	d, err := r.newDelegate(assembly, typ, method, t, sig)
+	if err != nil {
+		return fn, err
+	}
+	reflect.ValueOf(&fn).Elem().Set(reflect.MakeFunc(t, d.reflectCall(t)))
+	return fn, nil
+}
+
+// funcSignature returns the signature matching a Go function type.
+func funcSignature(t reflect.Type) (signature, error) {
+	var sig signature
+	if t.Kind() != reflect.Func {
+		return sig, fmt.Errorf("%w: %s isn't a function", ErrInvalidSignature, t)
+	}
+	results := t.NumOut()
+	if results > 0 && t.Out(results-1) == errorType {
+		sig.returnsError = true
+		results--
+	}
+	if t.IsVariadic() || t.NumIn() > MaxDelegateArity || results > 1 {
+		return sig, fmt.Errorf("%w: %s, expected up to %d parameters, one result and an error", ErrInvalidSignature, t, MaxDelegateArity)
+	}
+	for i := 0; i < t.NumIn(); i++ {
+		k, err := reflectKind(t, t.In(i))
+		if err != nil {
+			return sig, err
+		}
+		sig.params = append(sig.params, k)
+	}
+	if results == 1 {
+		k, err := reflectKind(t, t.Out(0))
+		if err != nil {
+			return sig, err
+		}
+		sig.result = k
+	}
+	if sig.result == kindStructPointer {
+		return sig, fmt.Errorf("%w: %s, struct pointers can't be returned", ErrInvalidSignature, t)
+	}
+	if n := len(sig.native().params); n > MaxDelegateArity {
+		return sig, fmt.Errorf("%w: %s takes %d native parameters, slices take two and a struct result one, expected up to %d", ErrInvalidSignature, t, n, MaxDelegateArity)
+	}
+	return sig, nil
+}
+
+var reflectKinds = map[reflect.Kind]kind{
+	reflect.Int8:          kindInt8,
+	reflect.Int16:         kindInt16,
+	reflect.Int32:         kindInt32,
+	reflect.Int64:         kindInt64,
+	reflect.Uint8:         kindUint8,
+	reflect.Uint16:        kindUint16,
+	reflect.Uint32:        kindUint32,
+	reflect.Uint64:        kindUint64,
+	reflect.Uintptr:       kindUintptr,
+	reflect.UnsafePointer: kindPointer,
+	reflect.Float32:       kindFloat32,
+	reflect.Float64:       kindFloat64,
+	reflect.String:        kindString,
+}
+
+var (
+	utf16StringType = reflect.TypeOf(UTF16String(""))
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func reflectKind(f, t reflect.Type) (kind, error) {
+	if t == utf16StringType {
+		return kindUTF16String, nil
+	}
+	if k, ok := reflectKinds[t.Kind()]; ok {
+		return k, nil
+	}
+	if t.Kind() == reflect.Struct {
+		return kindStruct, nil
+	}
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		return kindStructPointer, nil
+	}
+	if t.Kind() == reflect.Slice {
+		if k, ok := reflectKinds[t.Elem().Kind()]; ok && k != kindPointer && !k.isString() {
+			return kindSlice, nil
+		}
+	}
+	return kindVoid, fmt.Errorf("%w: %s, unsupported type %s", ErrInvalidSignature, f, t)
+}
+
+// reflectCall returns the reflect.MakeFunc implementation of the delegate.
+func (d *Delegate) reflectCall(t reflect.Type) func([]reflect.Value) []reflect.Value {
+	// fail returns err as the error result, or panics if the function doesn't have one.
+	fail := func(err error) []reflect.Value {
+		if !d.sig.returnsError {
+			panic(err)
+		}
+		out := make([]reflect.Value, t.NumOut())
+		for i := range out {
+			out[i] = reflect.Zero(t.Out(i))
+		}
+		out[len(out)-1] = reflect.ValueOf(&err).Elem()
+		return out
+	}
+	return func(in []reflect.Value) []reflect.Value {
+		// raw holds the native arguments, n counts them: slices take two.
+		var raw [nativeArity]uint64
+		n := 0
+		// structs holds the copies of the structs passed by value and the struct result.
+		var structs [nativeArity]reflect.Value
+		// pinner pins the Go memory passed to managed code, it can't be moved or freed until the call returns.
+		var pinner runtime.Pinner
+		defer pinner.Unpin()
+		pin := func(p unsafe.Pointer) uint64 {
+			if p != nil {
+				pinner.Pin(p)
+			}
+			return uint64(uintptr(p))
+		}
+		for i, v := range in {
+			switch k := d.sig.params[i]; k {
+			case kindStruct:
+				structs[n] = reflect.New(v.Type())
+				structs[n].Elem().Set(v)
+				raw[n] = pin(structs[n].UnsafePointer())
+			case kindSlice:
+				if v.Len() > math.MaxInt32 {
+					return fail(fmt.Errorf("%s argument %d: %w: %d elements don't fit a Span", d, i, ErrInvalidArgument, v.Len()))
+				}
+				raw[n] = pin(v.UnsafePointer())
+				n++
+				raw[n] = uint64(v.Len())
+			case kindPointer, kindStructPointer:
+				raw[n] = pin(v.UnsafePointer())
+			default:
+				raw[n] = k.reflectArgument(v)
+				if k.isString() {
+					defer FreeCoTaskMem(rawPointer(raw[n]))
+				}
+			}
+			n++
+		}
+		if d.sig.result == kindStruct {
+			structs[n] = reflect.New(t.Out(0))
+			raw[n] = pin(structs[n].UnsafePointer())
+		}
+		result, err := d.call(&raw)
+		if err != nil {
+			return fail(err)
+		}
+		var out []reflect.Value
+		switch {
+		case d.sig.result == kindStruct:
+			out = append(out, structs[n].Elem())
+		case d.sig.result.isString():
+			defer FreeCoTaskMem(rawPointer(result))
+			fallthrough
+		case d.sig.result != kindVoid:
+			out = append(out, d.sig.result.reflectResult(result, t.Out(0)))
+		}
+		if d.sig.returnsError {
+			out = append(out, reflect.Zero(errorType))
+		}
+		return out
+	}
+}
+
+// reflectArgument is kind.argument for a value of a type accepted by funcSignature.
+// Strings are copied to native memory owned by the caller.
+func (k kind) reflectArgument(v reflect.Value) uint64 {
+	switch {
+	case k.isString():
+		return uint64(uintptr(k.newString(v.String())))
+	case k == kindPointer, k == kindStructPointer:
+		return uint64(v.Pointer())
+	case k == kindFloat32:
+		return uint64(math.Float32bits(float32(v.Float())))
+	case k == kindFloat64:
+		return math.Float64bits(v.Float())
+	case k.signed():
+		return uint64(v.Int())
+	}
+	return v.Uint()
+}
+
+// reflectResult is kind.result for a type accepted by funcSignature.
+func (k kind) reflectResult(r uint64, t reflect.Type) reflect.Value {
+	return reflect.ValueOf(k.result(r)).Convert(t)
+}
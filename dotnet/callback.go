@@ -0,0 +1,184 @@
+package dotnet
+
+/*
+#include <stdint.h>
+#include "trampolines.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// ErrTooManyCallbacks is returned by NewCallback when MaxCallbacks callbacks are registered.
+var ErrTooManyCallbacks = errors.New("Too many callbacks")
+
+// ErrCallbacksNotSupported is returned by NewCallback on the platforms whose calling convention
+// doesn't match the native stubs, see NewCallback.
+var ErrCallbacksNotSupported = errors.New("Callbacks aren't supported on this platform")
+
+// MaxCallbacks is the maximum number of registered callbacks.
+const MaxCallbacks = C.DELEGATE_CALLBACK_SLOTS
+
+var (
+	// callbacks holds the registered callbacks by slot, every slot has its own native stub
+	// that calls goCallback with the slot number, Go pointers can't be handed to managed code.
+	callbacksMu sync.RWMutex
+	callbacks   [MaxCallbacks]*Callback
+)
+
+// Callback is a Go function that managed code can call through a native function pointer.
+//
+// Callbacks run synchronously on the thread that calls them. When managed code calls one during
+// a Delegate call, it runs on the thread of the calling goroutine, calls made from managed threads
+// (like the thread pool) run on a thread that cgo attaches to Go for the duration of the call.
+// A callback may call into the runtime again, but it must not call Shutdown. A panic in the
+// callback is recovered and logged, and the managed caller receives the zero value.
+type Callback struct {
+	runtime *Runtime
+	slot    int
+	sig     signature
+	fn      reflect.Value
+	f       unsafe.Pointer
+
+	closeOnce sync.Once
+}
+
+// NewCallback registers fn and returns a Callback whose Pointer can be passed to managed code as an IntPtr,
+// then turned into a delegate with Marshal.GetDelegateForFunctionPointer. fn follows the same rules as the
+// functions created by Bind, func(int32, int32) int32 matches the managed delegate int Op(int a, int b).
+// Closures are fine, fn is never handed to managed code. String parameters are copied, a string result is
+// allocated with StringToUTF8 or StringToUTF16 and released by the managed marshaler.
+//
+// Close must be called once managed code doesn't use the pointer anymore: the native stubs are generated,
+// so only MaxCallbacks (128) callbacks can be registered at the same time, and ErrTooManyCallbacks is returned
+// afterwards. Shutdown closes the callbacks of the runtime.
+//
+// The stubs take MaxDelegateArity integer and MaxDelegateArity double parameters and pick the declared ones,
+// which relies on the System V AMD64 and AArch64 calling conventions: they pass the integer and the floating
+// point arguments in separate registers, in order, and a float in the low bits of its register. Callbacks are
+// only supported on amd64 and arm64, other than Windows, NewCallback returns ErrCallbacksNotSupported elsewhere.
+func (r *Runtime) NewCallback(fn interface{}) (*Callback, error) {
+	release, err := r.checkRunning(OpCreateCallback)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if !callbacksSupported() {
+		return nil, fmt.Errorf("%w: %s/%s", ErrCallbacksNotSupported, runtime.GOOS, runtime.GOARCH)
+	}
+	v := reflect.ValueOf(fn)
+	if !v.IsValid() || (v.Kind() == reflect.Func && v.IsNil()) {
+		return nil, fmt.Errorf("%w: nil callback", ErrInvalidSignature)
+	}
+	sig, err := funcSignature(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	if sig.bindOnly() || sig.returnsError {
+		return nil, fmt.Errorf("%w: %s, callbacks don't support structs, slices and errors", ErrInvalidSignature, v.Type())
+	}
+
+	c := &Callback{runtime: r, sig: sig, fn: v}
+	callbacksMu.Lock()
+	defer callbacksMu.Unlock()
+	for slot, registered := range callbacks {
+		if registered == nil {
+			c.slot = slot
+			c.f = C.callbackStub(C.int(sig.result.class()), C.int(slot))
+			callbacks[slot] = c
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d callbacks are registered", ErrTooManyCallbacks, MaxCallbacks)
+}
+
+// callbacksSupported reports whether the calling convention of the platform matches the callback stubs.
+func callbacksSupported() bool {
+	switch runtime.GOARCH {
+	case "amd64", "arm64":
+		return runtime.GOOS != "windows"
+	}
+	return false
+}
+
+// Pointer returns the native function pointer.
+func (c *Callback) Pointer() unsafe.Pointer {
+	return c.f
+}
+
+// Signature returns the callback signature in the NewDelegate format, like "int32(int32,int32)".
+func (c *Callback) Signature() string {
+	return c.sig.String()
+}
+
+// Close unregisters the callback, its pointer can be reused by another callback afterwards.
+// Close can be called more than once.
+func (c *Callback) Close() error {
+	c.closeOnce.Do(func() {
+		callbacksMu.Lock()
+		callbacks[c.slot] = nil
+		callbacksMu.Unlock()
+	})
+	return nil
+}
+
+// closeCallbacks closes the callbacks registered by the runtime, it's called by Shutdown.
+func (r *Runtime) closeCallbacks() {
+	var registered []*Callback
+	callbacksMu.RLock()
+	for _, c := range callbacks {
+		if c != nil && c.runtime == r {
+			registered = append(registered, c)
+		}
+	}
+	callbacksMu.RUnlock()
+	for _, c := range registered {
+		c.Close()
+	}
+}
+
+//export goCallback
+func goCallback(slot C.int, ints *C.int64_t, floats *C.double, result *C.uint64_t) {
+	callbacksMu.RLock()
+	c := callbacks[slot]
+	callbacksMu.RUnlock()
+	if c == nil {
+		return
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			*result = 0
+			c.runtime.log(LevelError, "Callback panicked", Fields{"panic": fmt.Sprint(p), "signature": c.sig.String()})
+		}
+	}()
+
+	intArgs := (*[MaxDelegateArity]int64)(unsafe.Pointer(ints))
+	floatArgs := (*[MaxDelegateArity]float64)(unsafe.Pointer(floats))
+	t := c.fn.Type()
+	in := make([]reflect.Value, len(c.sig.params))
+	var nextInt, nextFloat int
+	for i, k := range c.sig.params {
+		var raw uint64
+		switch k.class() {
+		case classInt:
+			raw = uint64(intArgs[nextInt])
+			nextInt++
+		default:
+			// float32 arguments are in the low 32 bits, like the raw delegate values.
+			raw = math.Float64bits(floatArgs[nextFloat])
+			nextFloat++
+		}
+		in[i] = k.reflectResult(raw, t.In(i))
+	}
+	out := c.fn.Call(in)
+	if len(out) == 1 {
+		*result = C.uint64_t(c.sig.result.reflectArgument(out[0]))
+	}
+}
@@ -0,0 +1,164 @@
+package dotnet
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestCallback(t *testing.T) {
+	calls := 0
+	multiply, err := testRuntime.NewCallback(func(a, b int32) int32 {
+		calls++
+		return a * b
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer multiply.Close()
+	if multiply.Signature() != "int32(int32,int32)" || multiply.Pointer() == nil {
+		t.Fatalf("Unexpected callback %s", multiply.Signature())
+	}
+
+	apply, err := Bind[func(unsafe.Pointer, int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := apply(multiply.Pointer(), 6, 7); result != 42 || calls != 1 {
+		t.Fatalf("Got %d after %d calls", result, calls)
+	}
+
+	applyOnThread, err := Bind[func(unsafe.Pointer, int32, int32) int32](testRuntime, "Test", "Test.TestClass", "ApplyOnThread")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyOnThread(multiply.Pointer(), -3, 5); result != -15 || calls != 2 {
+		t.Fatalf("Got %d after %d calls", result, calls)
+	}
+
+	scale, err := testRuntime.NewCallback(func(value float64, factor float32) float64 {
+		return value * float64(factor)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer scale.Close()
+	applyScale, err := Bind[func(unsafe.Pointer, float64, float32) float64](testRuntime, "Test", "Test.TestClass", "ApplyScale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyScale(scale.Pointer(), 1.5, 4); result != 6 {
+		t.Fatalf("Got %v", result)
+	}
+
+	mix, err := testRuntime.NewCallback(func(a float64, b int32, c float32, d int64) float32 {
+		if d != 1<<40 {
+			return -1
+		}
+		return float32(a) + float32(b) + c
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mix.Close()
+	applyMix, err := Bind[func(unsafe.Pointer) float32](testRuntime, "Test", "Test.TestClass", "ApplyMix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyMix(mix.Pointer()); result != -0.25 {
+		t.Fatalf("Got %v", result)
+	}
+
+	// Every float32 argument is read from its own register, ints and floats are interleaved:
+	var floats [4]float32
+	floatsCallback, err := testRuntime.NewCallback(func(a, b, c, d float32) float32 {
+		floats = [4]float32{a, b, c, d}
+		return a + b + c
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer floatsCallback.Close()
+	applyFloats, err := Bind[func(unsafe.Pointer) float32](testRuntime, "Test", "Test.TestClass", "ApplyFloats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyFloats(floatsCallback.Pointer()); result != 2.375 || floats != [4]float32{1.5, -2.25, 3.125, 1e30} {
+		t.Fatalf("Got %v with %v", result, floats)
+	}
+	type interleavedArgs struct {
+		a float32
+		b int64
+		c float64
+		d int32
+	}
+	var interleaved interleavedArgs
+	interleavedCallback, err := testRuntime.NewCallback(func(a float32, b int64, c float64, d int32) int64 {
+		interleaved = interleavedArgs{a, b, c, d}
+		return b + int64(d)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer interleavedCallback.Close()
+	applyInterleaved, err := Bind[func(unsafe.Pointer) int64](testRuntime, "Test", "Test.TestClass", "ApplyInterleaved")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyInterleaved(interleavedCallback.Pointer()); result != -(1<<33)+7 || interleaved != (interleavedArgs{-0.75, -(1 << 33), 2.5, 7}) {
+		t.Fatalf("Got %v with %+v", result, interleaved)
+	}
+
+	// Callbacks can call into the runtime again:
+	add, err := Bind[func(int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Add")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reentrant, err := testRuntime.NewCallback(func(a, b int32) int32 {
+		return add(a, b)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reentrant.Close()
+	if result := apply(reentrant.Pointer(), 40, 2); result != 42 {
+		t.Fatalf("Got %d", result)
+	}
+
+	panics, err := testRuntime.NewCallback(func(a, b int32) int32 {
+		panic("callback")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := apply(panics.Pointer(), 1, 2); result != 0 {
+		t.Fatalf("Got %d", result)
+	}
+	for i := 0; i < 2; i++ {
+		if err := panics.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var registered []*Callback
+	for {
+		c, err := testRuntime.NewCallback(func() {})
+		if errors.Is(err, ErrTooManyCallbacks) {
+			break
+		}
+		if err != nil || len(registered) == MaxCallbacks {
+			t.Fatalf("Got %v after %d callbacks", err, len(registered))
+		}
+		registered = append(registered, c)
+	}
+	for _, c := range registered {
+		c.Close()
+	}
+
+	if _, err := testRuntime.NewCallback(func(s []byte) {}); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.NewCallback(nil); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+}
@@ -0,0 +1,474 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	appContextBaseDirectoryProperty = "APP_CONTEXT_BASE_DIRECTORY"
+	appContextDepsFilesProperty     = "APP_CONTEXT_DEPS_FILES"
+	appPathsProperty                = "APP_PATHS"
+	nativeSearchDirectoriesProperty = "NATIVE_DLL_SEARCH_DIRECTORIES"
+	probingDirectoriesProperty      = "PROBING_DIRECTORIES"
+	fxDepsFileProperty              = "FX_DEPS_FILE"
+)
+
+// frameworkReference is a framework requested by an application or by another framework.
+type frameworkReference struct {
+	Name        string
+	Version     string
+	RollForward RollForward
+}
+
+// runtimeConfig holds the settings read from a *.runtimeconfig.json file.
+type runtimeConfig struct {
+	Frameworks             []frameworkReference
+	Properties             map[string]string
+	AdditionalProbingPaths []string
+}
+
+// runtimeConfigFile is the JSON layout of a *.runtimeconfig.json file.
+type runtimeConfigFile struct {
+	RuntimeOptions struct {
+		RollForward                string                   `json:"rollForward"`
+		RollForwardOnNoCandidateFx *int                     `json:"rollForwardOnNoCandidateFx"`
+		ApplyPatches               *bool                    `json:"applyPatches"`
+		Framework                  *runtimeConfigFramework  `json:"framework"`
+		Frameworks                 []runtimeConfigFramework `json:"frameworks"`
+		ConfigProperties           map[string]interface{}   `json:"configProperties"`
+		AdditionalProbingPaths     []string                 `json:"additionalProbingPaths"`
+		IncludedFrameworks         []runtimeConfigFramework `json:"includedFrameworks"`
+	} `json:"runtimeOptions"`
+}
+
+type runtimeConfigFramework struct {
+	Name                       string `json:"name"`
+	Version                    string `json:"version"`
+	RollForward                string `json:"rollForward"`
+	RollForwardOnNoCandidateFx *int   `json:"rollForwardOnNoCandidateFx"`
+	ApplyPatches               *bool  `json:"applyPatches"`
+}
+
+// readRuntimeConfig parses a *.runtimeconfig.json file.
+// The additional probing paths from a *.runtimeconfig.dev.json file next to it are included too.
+func readRuntimeConfig(path string) (*runtimeConfig, error) {
+	var file runtimeConfigFile
+	if err := readJSON(path, &file); err != nil {
+		return nil, err
+	}
+	options := file.RuntimeOptions
+	if len(options.IncludedFrameworks) > 0 {
+		return nil, fmt.Errorf("%s: self-contained applications aren't supported", path)
+	}
+
+	config := &runtimeConfig{
+		Properties:             make(map[string]string, len(options.ConfigProperties)),
+		AdditionalProbingPaths: options.AdditionalProbingPaths,
+	}
+
+	defaultPolicy, err := rollForwardSetting(options.RollForward, options.RollForwardOnNoCandidateFx, options.ApplyPatches, RollForwardMinor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	frameworks := options.Frameworks
+	if options.Framework != nil {
+		frameworks = append([]runtimeConfigFramework{*options.Framework}, frameworks...)
+	}
+	for _, f := range frameworks {
+		policy, err := rollForwardSetting(f.RollForward, f.RollForwardOnNoCandidateFx, f.ApplyPatches, defaultPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		config.Frameworks = append(config.Frameworks, frameworkReference{
+			Name:        f.Name,
+			Version:     f.Version,
+			RollForward: policy,
+		})
+	}
+
+	for k, v := range options.ConfigProperties {
+		switch value := v.(type) {
+		case string:
+			config.Properties[k] = value
+		case bool:
+			config.Properties[k] = strconv.FormatBool(value)
+		case float64:
+			config.Properties[k] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("%s: unsupported value for %s", path, k)
+		}
+	}
+
+	devPath := strings.TrimSuffix(path, ".json") + ".dev.json"
+	var devFile runtimeConfigFile
+	if err := readJSON(devPath, &devFile); err == nil {
+		config.AdditionalProbingPaths = append(config.AdditionalProbingPaths, devFile.RuntimeOptions.AdditionalProbingPaths...)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return config, nil
+}
+
+// rollForwardSetting resolves the rollForward setting, or the legacy rollForwardOnNoCandidateFx
+// and applyPatches settings when it's missing.
+func rollForwardSetting(rollForward string, onNoCandidateFx *int, applyPatches *bool, defaultPolicy RollForward) (RollForward, error) {
+	if rollForward != "" {
+		return ParseRollForward(rollForward)
+	}
+	if onNoCandidateFx == nil && applyPatches == nil {
+		return defaultPolicy, nil
+	}
+	patches := applyPatches == nil || *applyPatches
+	switch {
+	case onNoCandidateFx == nil || *onNoCandidateFx == 1:
+		return RollForwardMinor, nil
+	case *onNoCandidateFx == 2:
+		return RollForwardMajor, nil
+	case *onNoCandidateFx == 0 && patches:
+		return RollForwardLatestPatch, nil
+	case *onNoCandidateFx == 0:
+		return RollForwardDisable, nil
+	}
+	return 0, fmt.Errorf("Invalid rollForwardOnNoCandidateFx value %d", *onNoCandidateFx)
+}
+
+// depsFile is the JSON layout of a *.deps.json file.
+type depsFile struct {
+	RuntimeTarget struct {
+		Name string `json:"name"`
+	} `json:"runtimeTarget"`
+	Targets   map[string]map[string]depsTarget `json:"targets"`
+	Libraries map[string]depsLibrary           `json:"libraries"`
+}
+
+type depsTarget struct {
+	Runtime        map[string]json.RawMessage   `json:"runtime"`
+	Native         map[string]json.RawMessage   `json:"native"`
+	RuntimeTargets map[string]depsRuntimeTarget `json:"runtimeTargets"`
+}
+
+type depsRuntimeTarget struct {
+	RID       string `json:"rid"`
+	AssetType string `json:"assetType"`
+}
+
+type depsLibrary struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+// depsAssets holds the files resolved from a deps.json file.
+type depsAssets struct {
+	Assemblies        []string
+	NativeDirectories []string
+}
+
+// readDeps parses a *.deps.json file.
+func readDeps(path string) (*depsFile, error) {
+	var deps depsFile
+	if err := readJSON(path, &deps); err != nil {
+		return nil, err
+	}
+	if _, ok := deps.Targets[deps.RuntimeTarget.Name]; !ok {
+		return nil, fmt.Errorf("%s: missing target %q", path, deps.RuntimeTarget.Name)
+	}
+	return &deps, nil
+}
+
+// resolve finds the runtime assemblies and native libraries listed in the deps.json file.
+// Assets are looked up in the application directory first and then in the probing paths,
+// which usually include the NuGet package cache. Libraries and assets are resolved in name order,
+// so that the same assembly list is built on every start.
+func (d *depsFile) resolve(appDir string, probingPaths []string) (*depsAssets, error) {
+	assets := &depsAssets{}
+	nativeDirs := make(map[string]bool)
+	rids := ridFallbacks()
+
+	targets := d.Targets[d.RuntimeTarget.Name]
+	for _, libraryName := range sortedKeys(targets) {
+		target := targets[libraryName]
+		library := d.Libraries[libraryName]
+
+		runtimeAssets := sortedKeys(target.Runtime)
+		nativeAssets := sortedKeys(target.Native)
+		// Only the most specific RID that has assets is used:
+		for _, rid := range rids {
+			found := false
+			for _, asset := range sortedKeys(target.RuntimeTargets) {
+				runtimeTarget := target.RuntimeTargets[asset]
+				if runtimeTarget.RID != rid {
+					continue
+				}
+				found = true
+				switch runtimeTarget.AssetType {
+				case "runtime":
+					runtimeAssets = append(runtimeAssets, asset)
+				case "native":
+					nativeAssets = append(nativeAssets, asset)
+				}
+			}
+			if found {
+				break
+			}
+		}
+
+		for _, asset := range runtimeAssets {
+			path, err := probeAsset(appDir, probingPaths, libraryName, library, asset)
+			if err != nil {
+				return nil, err
+			}
+			assets.Assemblies = append(assets.Assemblies, path)
+		}
+		for _, asset := range nativeAssets {
+			path, err := probeAsset(appDir, probingPaths, libraryName, library, asset)
+			if err != nil {
+				return nil, err
+			}
+			dir := filepath.Dir(path)
+			if !nativeDirs[dir] {
+				nativeDirs[dir] = true
+				assets.NativeDirectories = append(assets.NativeDirectories, dir)
+			}
+		}
+	}
+	return assets, nil
+}
+
+// sortedKeys returns the keys of m in increasing order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// probeAsset returns the location of a deps.json asset.
+func probeAsset(appDir string, probingPaths []string, libraryName string, library depsLibrary, asset string) (string, error) {
+	candidates := []string{
+		filepath.Join(appDir, filepath.FromSlash(asset)),
+		filepath.Join(appDir, filepath.Base(asset)),
+	}
+	if library.Type == "package" {
+		libraryPath := library.Path
+		if libraryPath == "" {
+			libraryPath = strings.ToLower(strings.Replace(libraryName, "/", string(filepath.Separator), 1))
+		}
+		for _, probingPath := range probingPaths {
+			candidates = append(candidates, filepath.Join(probingPath, filepath.FromSlash(libraryPath), filepath.FromSlash(asset)))
+		}
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("An assembly specified in the application dependencies manifest was not found: package %s, path %s", libraryName, asset)
+}
+
+// ridFallbacks returns the runtime identifiers for the current platform, most specific first.
+func ridFallbacks() []string {
+	var platform string
+	switch runtime.GOOS {
+	case "darwin":
+		platform = "osx"
+	case "windows":
+		platform = "win"
+	default:
+		platform = runtime.GOOS
+	}
+	var rids []string
+	if arch := dotnetArchs[runtime.GOARCH]; arch != "" {
+		rids = append(rids, platform+"-"+arch)
+	}
+	rids = append(rids, platform)
+	if runtime.GOOS != "windows" {
+		rids = append(rids, "unix")
+	}
+	return append(rids, "any")
+}
+
+// nugetPackagesPath returns the NuGet package cache location.
+func nugetPackagesPath() string {
+	if path := os.Getenv("NUGET_PACKAGES"); path != "" {
+		return path
+	}
+	return filepath.Join(os.Getenv("HOME"), ".nuget", "packages")
+}
+
+// readJSON decodes a JSON file.
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	return nil
+}
+
+// resolveProperties selects the frameworks and builds the properties passed to the runtime,
+// merging the runtimeconfig.json and deps.json settings with RuntimeParams.Properties, which take precedence.
+func (r *Runtime) resolveProperties() (map[string]string, error) {
+	properties := make(map[string]string)
+	references := []frameworkReference{{Name: frameworkName}}
+	appDir := r.appDir()
+	var probingPaths []string
+
+	if path := r.Params.RuntimeConfigPath; path != "" {
+		config, err := readRuntimeConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(config.Frameworks) > 0 {
+			references = config.Frameworks
+		}
+		for k, v := range config.Properties {
+			properties[k] = v
+		}
+		probingPaths = config.AdditionalProbingPaths
+	}
+
+	frameworks, err := r.resolveFrameworks(references)
+	if err != nil {
+		return nil, err
+	}
+
+	var frameworkDirs []string
+	for _, f := range frameworks {
+		frameworkDirs = append(frameworkDirs, f.Path)
+	}
+	assemblies := tpaAssemblies(frameworkDirs...)
+
+	if appDir != "" {
+		assets, err := r.appAssets(appDir, probingPaths)
+		if err != nil {
+			return nil, err
+		}
+		assemblies = mergeAssemblies(assemblies, assets.Assemblies)
+		if r.Params.DepsFilePath != "" {
+			properties[appContextDepsFilesProperty] = r.Params.DepsFilePath
+		}
+		properties[appContextBaseDirectoryProperty] = appDir + string(filepath.Separator)
+		nativeDirs := append([]string{appDir}, assets.NativeDirectories...)
+		nativeDirs = append(nativeDirs, frameworkDirs...)
+		properties[nativeSearchDirectoriesProperty] = strings.Join(nativeDirs, string(os.PathListSeparator))
+		properties[fxDepsFileProperty] = filepath.Join(r.framework.Path, frameworkName+".deps.json")
+	}
+	if len(probingPaths) > 0 {
+		properties[probingDirectoriesProperty] = strings.Join(probingPaths, string(os.PathListSeparator))
+	}
+	properties[tpaProperty] = strings.Join(assemblies, string(os.PathListSeparator))
+
+	for k, v := range r.Params.Properties {
+		properties[k] = v
+	}
+	return properties, nil
+}
+
+// appDir returns the application directory, where the runtimeconfig.json or deps.json file is.
+func (r *Runtime) appDir() string {
+	switch {
+	case r.Params.RuntimeConfigPath != "":
+		return filepath.Dir(r.Params.RuntimeConfigPath)
+	case r.Params.DepsFilePath != "":
+		return filepath.Dir(r.Params.DepsFilePath)
+	}
+	return ""
+}
+
+// appAssets resolves the application assemblies and native directories from the deps.json file.
+// Without a deps.json file every assembly in the application directory is used.
+func (r *Runtime) appAssets(appDir string, probingPaths []string) (*depsAssets, error) {
+	if r.Params.DepsFilePath == "" {
+		return &depsAssets{Assemblies: tpaAssemblies(appDir)}, nil
+	}
+	deps, err := readDeps(r.Params.DepsFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return deps.resolve(appDir, append(probingPaths, nugetPackagesPath()))
+}
+
+// resolveFrameworks selects every referenced framework, including the frameworks they depend on.
+// RuntimeParams.CLRFilesAbsolutePath and FrameworkVersion override the Microsoft.NETCore.App reference.
+// The Microsoft.NETCore.App framework, which hosts the runtime, is kept as r.framework.
+func (r *Runtime) resolveFrameworks(references []frameworkReference) (frameworks []Framework, err error) {
+	resolved := make(map[string]bool)
+	for len(references) > 0 {
+		ref := references[0]
+		references = references[1:]
+		if resolved[ref.Name] {
+			continue
+		}
+		resolved[ref.Name] = true
+
+		var f Framework
+		switch {
+		case ref.Name == frameworkName && r.Params.CLRFilesAbsolutePath != "":
+			f = Framework{Name: frameworkName, Path: r.Params.CLRFilesAbsolutePath}
+			f.Version, _ = ParseVersion(filepath.Base(r.Params.CLRFilesAbsolutePath))
+		case ref.Name == frameworkName && (r.Params.FrameworkVersion != "" || ref.Version == ""):
+			f, err = selectFramework(ref.Name, locateFrameworks(ref.Name), r.Params.FrameworkVersion, r.Params.RollForward)
+		default:
+			f, err = selectFramework(ref.Name, locateFrameworks(ref.Name), ref.Version, ref.RollForward)
+		}
+		if err != nil {
+			return nil, err
+		}
+		frameworks = append(frameworks, f)
+		if f.Name == frameworkName {
+			r.framework = f
+		} else {
+			// Frameworks like Microsoft.AspNetCore.App reference Microsoft.NETCore.App in their own runtimeconfig.json:
+			config, err := readRuntimeConfig(filepath.Join(f.Path, f.Name+".runtimeconfig.json"))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			if config != nil {
+				references = append(references, config.Frameworks...)
+			}
+		}
+
+		if len(references) == 0 && !resolved[frameworkName] {
+			references = append(references, frameworkReference{Name: frameworkName})
+		}
+	}
+	return frameworks, nil
+}
+
+// mergeAssemblies appends the assemblies whose names aren't in the list yet.
+func mergeAssemblies(assemblies []string, extra []string) []string {
+	names := make(map[string]bool, len(assemblies))
+	for _, a := range assemblies {
+		names[assemblyName(a)] = true
+	}
+	for _, a := range extra {
+		if name := assemblyName(a); !names[name] {
+			names[name] = true
+			assemblies = append(assemblies, a)
+		}
+	}
+	return assemblies
+}
+
+// assemblyName returns the file name of an assembly without its extension.
+func assemblyName(path string) string {
+	name := filepath.Base(path)
+	for _, ext := range tpaExtensions {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
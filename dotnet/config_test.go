@@ -0,0 +1,239 @@
+package dotnet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writeFile creates a file and its parent directories inside dir.
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func tempDir(t *testing.T, prefix string) string {
+	dir, err := ioutil.TempDir("", prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestReadRuntimeConfig(t *testing.T) {
+	dir := tempDir(t, "runtimeconfig")
+	path := writeFile(t, dir, "App.runtimeconfig.json", `{
+  "runtimeOptions": {
+    "tfm": "netcoreapp3.1",
+    "rollForwardOnNoCandidateFx": 2,
+    "frameworks": [
+      {"name": "Microsoft.NETCore.App", "version": "3.1.0"},
+      {"name": "Microsoft.AspNetCore.App", "version": "3.1.0", "rollForward": "LatestPatch"}
+    ],
+    "additionalProbingPaths": ["/probe"],
+    "configProperties": {
+      "System.GC.Server": true,
+      "System.GC.HeapCount": 4,
+      "Custom": "value"
+    }
+  }
+}`)
+	writeFile(t, dir, "App.runtimeconfig.dev.json", `{"runtimeOptions": {"additionalProbingPaths": ["/dev/probe"]}}`)
+
+	config, err := readRuntimeConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedFrameworks := []frameworkReference{
+		{Name: frameworkName, Version: "3.1.0", RollForward: RollForwardMajor},
+		{Name: "Microsoft.AspNetCore.App", Version: "3.1.0", RollForward: RollForwardLatestPatch},
+	}
+	if !reflect.DeepEqual(config.Frameworks, expectedFrameworks) {
+		t.Errorf("Frameworks = %+v, expected %+v", config.Frameworks, expectedFrameworks)
+	}
+	expectedProperties := map[string]string{
+		"System.GC.Server":    "true",
+		"System.GC.HeapCount": "4",
+		"Custom":              "value",
+	}
+	if !reflect.DeepEqual(config.Properties, expectedProperties) {
+		t.Errorf("Properties = %v, expected %v", config.Properties, expectedProperties)
+	}
+	if expected := []string{"/probe", "/dev/probe"}; !reflect.DeepEqual(config.AdditionalProbingPaths, expected) {
+		t.Errorf("AdditionalProbingPaths = %v, expected %v", config.AdditionalProbingPaths, expected)
+	}
+
+	selfContained := writeFile(t, dir, "Self.runtimeconfig.json", `{"runtimeOptions": {"includedFrameworks": [{"name": "Microsoft.NETCore.App", "version": "3.1.0"}]}}`)
+	if _, err := readRuntimeConfig(selfContained); err == nil {
+		t.Error("Self-contained applications should be rejected")
+	}
+}
+
+func TestRollForwardSetting(t *testing.T) {
+	zero, one, two, three := 0, 1, 2, 3
+	yes, no := true, false
+	tests := []struct {
+		rollForward     string
+		onNoCandidateFx *int
+		applyPatches    *bool
+		expected        RollForward
+		fails           bool
+	}{
+		{"", nil, nil, RollForwardLatestMinor, false},
+		{"Major", &zero, nil, RollForwardMajor, false},
+		{"", &zero, nil, RollForwardLatestPatch, false},
+		{"", &zero, &no, RollForwardDisable, false},
+		{"", &one, &yes, RollForwardMinor, false},
+		{"", nil, &no, RollForwardMinor, false},
+		{"", &two, nil, RollForwardMajor, false},
+		{"", &three, nil, 0, true},
+		{"Sideways", nil, nil, 0, true},
+	}
+	for _, test := range tests {
+		policy, err := rollForwardSetting(test.rollForward, test.onNoCandidateFx, test.applyPatches, RollForwardLatestMinor)
+		if test.fails {
+			if err == nil {
+				t.Errorf("rollForwardSetting(%q) should fail", test.rollForward)
+			}
+			continue
+		}
+		if err != nil || policy != test.expected {
+			t.Errorf("rollForwardSetting(%q) = %s, %v, expected %s", test.rollForward, policy, err, test.expected)
+		}
+	}
+}
+
+func TestDepsResolve(t *testing.T) {
+	arch := dotnetArchs[runtime.GOARCH]
+	if arch == "" {
+		t.Skipf("Unsupported architecture %s", runtime.GOARCH)
+	}
+	rid := ridFallbacks()[0]
+	appDir := tempDir(t, "app")
+	packages := tempDir(t, "packages")
+	writeFile(t, appDir, "App.dll", "")
+	writeFile(t, packages, "newtonsoft.json/12.0.3/lib/netstandard2.0/Newtonsoft.Json.dll", "")
+	writeFile(t, packages, "native.lib/1.0.0/runtimes/"+rid+"/native/libnative.so", "")
+	writeFile(t, packages, "native.lib/1.0.0/runtimes/unix/native/libnative.so", "")
+	path := writeFile(t, appDir, "App.deps.json", `{
+  "runtimeTarget": {"name": ".NETCoreApp,Version=v3.1"},
+  "targets": {
+    ".NETCoreApp,Version=v3.1": {
+      "App/1.0.0": {"runtime": {"App.dll": {}}},
+      "Newtonsoft.Json/12.0.3": {"runtime": {"lib/netstandard2.0/Newtonsoft.Json.dll": {}}},
+      "Native.Lib/1.0.0": {
+        "runtimeTargets": {
+          "runtimes/unix/native/libnative.so": {"rid": "unix", "assetType": "native"},
+          "runtimes/`+rid+`/native/libnative.so": {"rid": "`+rid+`", "assetType": "native"}
+        }
+      }
+    }
+  },
+  "libraries": {
+    "App/1.0.0": {"type": "project"},
+    "Newtonsoft.Json/12.0.3": {"type": "package", "path": "newtonsoft.json/12.0.3"},
+    "Native.Lib/1.0.0": {"type": "package"}
+  }
+}`)
+
+	deps, err := readDeps(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assets, err := deps.resolve(appDir, []string{packages})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The libraries are resolved in name order, on every run:
+	expectedAssemblies := []string{
+		filepath.Join(appDir, "App.dll"),
+		filepath.Join(packages, "newtonsoft.json", "12.0.3", "lib", "netstandard2.0", "Newtonsoft.Json.dll"),
+	}
+	for i := 0; i < 10; i++ {
+		if !reflect.DeepEqual(assets.Assemblies, expectedAssemblies) {
+			t.Fatalf("Assemblies = %v, expected %v", assets.Assemblies, expectedAssemblies)
+		}
+		if assets, err = deps.resolve(appDir, []string{packages}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectedNative := []string{filepath.Join(packages, "native.lib", "1.0.0", "runtimes", rid, "native")}
+	if !reflect.DeepEqual(assets.NativeDirectories, expectedNative) {
+		t.Errorf("NativeDirectories = %v, expected %v", assets.NativeDirectories, expectedNative)
+	}
+
+	if _, err := deps.resolve(appDir, nil); err == nil {
+		t.Error("Missing package assets should fail")
+	}
+}
+
+func TestResolveProperties(t *testing.T) {
+	root := fakeRoot(t, "3.1.0", "3.1.5", "5.0.0")
+	defer os.RemoveAll(root)
+	setenv(t, "DOTNET_ROOT", root)
+	setenv(t, "DOTNET_ROOT_"+strings.ToUpper(dotnetArchs[runtime.GOARCH]), "")
+	fxDir := filepath.Join(root, "shared", frameworkName, "3.1.5")
+	writeFile(t, fxDir, "System.Runtime.dll", "")
+	writeFile(t, fxDir, "Newtonsoft.Json.dll", "")
+	aspDir := filepath.Join(root, "shared", "Microsoft.AspNetCore.App", "3.1.2")
+	writeFile(t, aspDir, "Microsoft.AspNetCore.dll", "")
+	writeFile(t, aspDir, "Microsoft.AspNetCore.App.runtimeconfig.json",
+		`{"runtimeOptions": {"framework": {"name": "Microsoft.NETCore.App", "version": "3.1.2"}}}`)
+
+	appDir := tempDir(t, "app")
+	writeFile(t, appDir, "App.dll", "")
+	writeFile(t, appDir, "Newtonsoft.Json.dll", "")
+	configPath := writeFile(t, appDir, "App.runtimeconfig.json", `{
+  "runtimeOptions": {
+    "framework": {"name": "Microsoft.AspNetCore.App", "version": "3.1.0"},
+    "configProperties": {"System.GC.Server": true, "Custom": "config"}
+  }
+}`)
+
+	r := &Runtime{Params: RuntimeParams{
+		RuntimeConfigPath: configPath,
+		Properties:        map[string]string{"Custom": "params"},
+	}}
+	properties, err := r.resolveProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.framework.Path != fxDir {
+		t.Errorf("Framework path = %s, expected %s", r.framework.Path, fxDir)
+	}
+	if properties["System.GC.Server"] != "true" || properties["Custom"] != "params" {
+		t.Errorf("Properties weren't merged: %v", properties)
+	}
+	if properties[appContextBaseDirectoryProperty] != appDir+string(filepath.Separator) {
+		t.Errorf("%s = %s", appContextBaseDirectoryProperty, properties[appContextBaseDirectoryProperty])
+	}
+	tpa := strings.Split(properties[tpaProperty], string(os.PathListSeparator))
+	expected := []string{
+		filepath.Join(aspDir, "Microsoft.AspNetCore.dll"),
+		filepath.Join(fxDir, "Newtonsoft.Json.dll"),
+		filepath.Join(fxDir, "System.Runtime.dll"),
+		filepath.Join(appDir, "App.dll"),
+	}
+	if !reflect.DeepEqual(tpa, expected) {
+		t.Errorf("TPA = %v, expected %v", tpa, expected)
+	}
+
+	r = &Runtime{Params: RuntimeParams{RuntimeConfigPath: configPath, FrameworkVersion: "5.0.0", RollForward: RollForwardDisable}}
+	if _, err := r.resolveProperties(); err != nil {
+		t.Fatal(err)
+	}
+	if r.framework.Version.Major != 5 {
+		t.Errorf("FrameworkVersion should override the runtimeconfig.json reference, got %s", r.framework.Version)
+	}
+}
@@ -0,0 +1,126 @@
+package dotnet
+
+/*
+#include "hostfxr.hpp"
+
+extern int goConsoleWrite(uintptr_t context, int stream, char* data, int length);
+*/
+import "C"
+
+import (
+	"io"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// Streams passed by the GoDotnet helper to goConsoleWrite.
+const (
+	consoleStdout = 1
+	consoleStderr = 2
+)
+
+var (
+	// consoles maps the context passed to the helper to the console of a runtime,
+	// Go pointers can't be handed to managed code.
+	consolesMu  sync.Mutex
+	consoles    = make(map[uintptr]*console)
+	nextConsole uintptr
+)
+
+// console receives the managed Console.Out and Console.Error output of a runtime.
+// A nil writer sends the output to the process stdout or stderr, like the managed Console does.
+type console struct {
+	mu     sync.Mutex
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// write forwards a chunk of managed output to the matching writer.
+func (c *console) write(stream int, p []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var w io.Writer
+	switch stream {
+	case consoleStdout:
+		w = c.stdout
+		if w == nil {
+			w = os.Stdout
+		}
+	case consoleStderr:
+		w = c.stderr
+		if w == nil {
+			w = os.Stderr
+		}
+	default:
+		return os.ErrInvalid
+	}
+	_, err := w.Write(p)
+	return err
+}
+
+// swap replaces the writers until restore is called, nil values keep the current ones.
+func (c *console) swap(stdout, stderr io.Writer) (restore func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	previousStdout, previousStderr := c.stdout, c.stderr
+	if stdout != nil {
+		c.stdout = stdout
+	}
+	if stderr != nil {
+		c.stderr = stderr
+	}
+	return func() {
+		c.mu.Lock()
+		c.stdout, c.stderr = previousStdout, previousStderr
+		c.mu.Unlock()
+	}
+}
+
+//export goConsoleWrite
+func goConsoleWrite(context C.uintptr_t, stream C.int, data *C.char, length C.int) C.int {
+	consolesMu.Lock()
+	c := consoles[uintptr(context)]
+	consolesMu.Unlock()
+	if c == nil {
+		return -1
+	}
+	if err := c.write(int(stream), C.GoBytes(unsafe.Pointer(data), length)); err != nil {
+		return -1
+	}
+	return 0
+}
+
+// redirectConsole replaces the managed Console.Out and Console.Error with writers that forward
+// their output to RuntimeParams.Stdout and Stderr. It only runs once per runtime.
+func (r *Runtime) redirectConsole(op Operation) error {
+	r.consoleMu.Lock()
+	defer r.consoleMu.Unlock()
+	if r.console != nil {
+		return nil
+	}
+	setConsole, err := r.helperFunction("SetConsole")
+	if err != nil {
+		return err
+	}
+
+	c := &console{stdout: r.Params.Stdout, stderr: r.Params.Stderr}
+	consolesMu.Lock()
+	nextConsole++
+	id := nextConsole
+	consoles[id] = c
+	consolesMu.Unlock()
+
+	args := C.setConsoleArgs{
+		write:   C.consoleWriteFn(C.goConsoleWrite),
+		context: C.uintptr_t(id),
+	}
+	if err := callHelper(op, setConsole, unsafe.Pointer(&args), unsafe.Sizeof(args)); err != nil {
+		consolesMu.Lock()
+		delete(consoles, id)
+		consolesMu.Unlock()
+		return err
+	}
+	r.console = c
+	return nil
+}
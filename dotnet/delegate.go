@@ -0,0 +1,306 @@
+package dotnet
+
+//go:generate go run trampolines_gen.go
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+#include "trampolines.h"
+
+// bindMethodArgs is passed to GoDotnet.Host.BindMethod, which sets result and description.
+typedef struct bindMethodArgs {
+  const char* assembly;
+  const char* type;
+  const char* method;
+  void* result;
+  char* description;
+  int64_t context;
+} bindMethodArgs;
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// MaxDelegateArity is the maximum number of parameters of a Delegate.
+const MaxDelegateArity = C.DELEGATE_MAX_ARITY
+
+// nativeArity is the maximum number of native parameters, see signature.native.
+const nativeArity = C.DELEGATE_NATIVE_ARITY
+
+// delegateTrampolines is the number of generated trampolines.
+const delegateTrampolines = C.DELEGATE_TRAMPOLINES
+
+// Delegate is a native function pointer to a static managed method that can be called
+// without writing any C: the arguments and the result are converted using its signature.
+type Delegate struct {
+	runtime               *Runtime
+	assembly, typ, method string
+
+	sig signature
+	// goType is the function type used by Bind, nil for NewDelegate.
+	goType reflect.Type
+	// plugin is set for the delegates of a Plugin, its lock guards the binding.
+	plugin *Plugin
+	// stats is nil when the metrics are disabled.
+	stats *methodStats
+	binding
+}
+
+// binding is a method bound by GoDotnet.Host.BindMethod.
+type binding struct {
+	trampoline C.int
+	f          unsafe.Pointer
+	// exceptionArg is the native parameter that receives the managed exceptions, -1 if they aren't caught.
+	exceptionArg C.int
+}
+
+// NewDelegate works like CreateDelegate and returns a Delegate that can be called with Call.
+// The signature describes the method as "result(params)" using Go type names, int Add(int, int) is
+// "int32(int32,int32)" and void Run() is "void()". The supported types are int8, int16, int32, int64,
+// uint8, uint16, uint32, uint64, uintptr, float32, float64, pointer for IntPtr and unmanaged pointers,
+// string for strings marshaled as UTF-8 (LPUTF8Str, or LPStr on Linux and macOS) and utf16string for LPWStr.
+// Up to MaxDelegateArity parameters are supported.
+//
+// The signature is checked against the managed method, each parameter and the result must have the matching
+// managed type: int for int32, uint for uint32, IntPtr or an unmanaged pointer for uintptr and pointer, and so on.
+// ErrInvalidSignature is returned otherwise. The delegate calls a wrapper emitted by the helper assembly that catches the managed exceptions, see Call.
+func (r *Runtime) NewDelegate(assembly, typ, method, signature string) (*Delegate, error) {
+	sig, err := parseSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	return r.newDelegate(assembly, typ, method, nil, sig)
+}
+
+// newDelegate binds a method through GoDotnet.Host.BindMethod, t is the Go function type used by Bind or nil.
+func (r *Runtime) newDelegate(assembly, typ, method string, t reflect.Type, sig signature) (*Delegate, error) {
+	d := &Delegate{runtime: r, assembly: assembly, typ: typ, method: method, sig: sig, goType: t}
+	b, err := r.bind(d, 0)
+	if err != nil {
+		return nil, err
+	}
+	d.binding = b
+	r.trackDelegate(d)
+	return d, nil
+}
+
+// trackDelegate counts a new delegate in the metrics, when they're enabled.
+func (r *Runtime) trackDelegate(d *Delegate) {
+	if r.metrics != nil {
+		key := d.key()
+		r.metrics.bind(key)
+		d.stats = r.metrics.method(key)
+	}
+}
+
+// bind binds the method of d in a load context, 0 for the default one, see LoadPlugin.
+func (r *Runtime) bind(d *Delegate, context uint64) (binding, error) {
+	var b binding
+	release, err := r.checkRunning(OpCreateDelegate)
+	if err != nil {
+		return b, err
+	}
+	defer release()
+	name := d.sig.String()
+	if d.goType != nil {
+		name = d.goType.String()
+	}
+	kinds, layouts, err := managedParams(d.goType, d.sig)
+	if err != nil {
+		return b, err
+	}
+
+	bindMethod, err := r.helperFunction("BindMethod")
+	if err != nil {
+		return b, err
+	}
+	args := C.bindMethodArgs{
+		assembly: C.CString(d.assembly),
+		_type:    C.CString(d.typ),
+		method:   C.CString(d.method),
+		context:  C.int64_t(context),
+	}
+	defer C.free(unsafe.Pointer(args.assembly))
+	defer C.free(unsafe.Pointer(args._type))
+	defer C.free(unsafe.Pointer(args.method))
+	if err := callHelper(OpCreateDelegate, bindMethod, unsafe.Pointer(&args), unsafe.Sizeof(args)); err != nil {
+		r.log(LevelError, err.Error(), Fields{"assembly": d.assembly, "type": d.typ, "method": d.method})
+		return b, err
+	}
+	description := C.GoString(args.description)
+	FreeCoTaskMem(unsafe.Pointer(args.description))
+
+	var managed struct {
+		Params  []*structLayout `json:"params"`
+		Result  *structLayout   `json:"result"`
+		Types   []string        `json:"types"`
+		Catches bool            `json:"catches"`
+	}
+	if err := json.Unmarshal([]byte(description), &managed); err != nil {
+		return b, err
+	}
+	if len(managed.Params) != len(kinds)-1 {
+		return b, fmt.Errorf("%w: %s, %s.%s has %d parameters", ErrInvalidSignature, name, d.typ, d.method, len(managed.Params))
+	}
+	if err := d.checkStructs(name, kinds, layouts, append(managed.Params, managed.Result)); err != nil {
+		return b, err
+	}
+	if err := d.checkTypes(name, managed.Types); err != nil {
+		return b, err
+	}
+
+	native := d.sig.native()
+	b.exceptionArg = -1
+	if managed.Catches {
+		b.exceptionArg = C.int(len(native.params))
+		native.params = append(native.params, kindPointer)
+	}
+	b.trampoline = C.int(native.trampoline())
+	b.f = args.result
+	return b, nil
+}
+
+// checkTypes checks the parameters and the result against the managed types, named by GoDotnet.Host.TypeName.
+// types has an entry per managed parameter and the result: slices take a pointer and an int length.
+func (d *Delegate) checkTypes(name string, types []string) error {
+	mismatch := func(i int) error {
+		return fmt.Errorf("%w: %s, %s of %s.%s is %s", ErrInvalidSignature, name, position(i, len(types)-1), d.typ, d.method, types[i])
+	}
+	i := 0
+	for j, k := range d.sig.params {
+		if k == kindSlice {
+			// The elements are passed as they are, the managed pointer must have the same type:
+			elem := reflectKinds[d.goType.In(j).Elem().Kind()]
+			if types[i] != "uintptr" && types[i] != "*"+elem.String() {
+				return mismatch(i)
+			}
+			i++
+			k = kindInt32
+		}
+		if !k.matches(types[i]) {
+			return mismatch(i)
+		}
+		i++
+	}
+	if !d.sig.result.matches(types[i]) {
+		return mismatch(i)
+	}
+	return nil
+}
+
+// Call calls the method, args are converted following the delegate signature. Integer parameters accept
+// any Go integer that fits, pointer parameters accept unsafe.Pointer, uintptr or nil and string parameters
+// accept string or UTF16String. The result has the Go type named in the signature, unsafe.Pointer for pointer,
+// string for both string types or nil for void.
+//
+// String arguments are copied to native memory released after the call, a returned string is copied
+// and released with FreeCoTaskMem, managed code allocates it with Marshal.AllocCoTaskMem.
+//
+// Pointers to Go memory follow the cgo rules: the memory must not contain Go pointers
+// and managed code must not keep the pointer after the call returns.
+//
+// An exception thrown by the method is returned as a *ManagedException.
+func (d *Delegate) Call(args ...interface{}) (interface{}, error) {
+	if len(args) != len(d.sig.params) {
+		return nil, fmt.Errorf("%w: %s expects %d arguments, got %d", ErrInvalidArgument, d, len(d.sig.params), len(args))
+	}
+	var raw [nativeArity]uint64
+	for i, k := range d.sig.params {
+		if k.isString() {
+			var s string
+			switch v := args[i].(type) {
+			case string:
+				s = v
+			case UTF16String:
+				s = string(v)
+			default:
+				return nil, fmt.Errorf("%s argument %d: %w: %T for %s", d, i, ErrInvalidArgument, v, k)
+			}
+			p := k.newString(s)
+			defer FreeCoTaskMem(p)
+			raw[i] = uint64(uintptr(p))
+			continue
+		}
+		v, err := k.argument(args[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s argument %d: %w", d, i, err)
+		}
+		raw[i] = v
+	}
+	result, err := d.call(&raw)
+	runtime.KeepAlive(args)
+	if err != nil {
+		return nil, err
+	}
+	if d.sig.result.isString() {
+		defer FreeCoTaskMem(rawPointer(result))
+	}
+	return d.sig.result.result(result), nil
+}
+
+// call calls the function pointer with raw arguments, see kind.argument.
+// It returns a *ManagedException when the method throws, an *UnloadedError when its plugin is unloaded
+// and a StateError when the runtime isn't running, Shutdown waits for the running calls.
+func (d *Delegate) call(args *[nativeArity]uint64) (uint64, error) {
+	if d.stats == nil {
+		return d.callNative(args)
+	}
+	start := time.Now()
+	result, err := d.callNative(args)
+	d.stats.observe(time.Since(start), err != nil)
+	return result, err
+}
+
+func (d *Delegate) callNative(args *[nativeArity]uint64) (uint64, error) {
+	release, err := d.runtime.checkRunning(OpCallDelegate)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+	if d.plugin != nil {
+		// Unload and Reload wait for the running calls.
+		d.plugin.mu.RLock()
+		defer d.plugin.mu.RUnlock()
+		if d.plugin.context == 0 {
+			return 0, &UnloadedError{Plugin: d.plugin.path, Method: d.typ + "." + d.method}
+		}
+	}
+	var result C.uint64_t
+	exception := C.callDelegate(d.f, d.trampoline, (*C.uint64_t)(unsafe.Pointer(&args[0])), d.exceptionArg, &result)
+	if exception != nil {
+		return 0, newManagedException(exception)
+	}
+	return uint64(result), nil
+}
+
+// Pointer returns the native function pointer. The pointer of a Plugin delegate changes when the plugin
+// is reloaded, and is invalid once it's unloaded.
+func (d *Delegate) Pointer() unsafe.Pointer {
+	if d.plugin != nil {
+		d.plugin.mu.RLock()
+		defer d.plugin.mu.RUnlock()
+	}
+	return d.f
+}
+
+// Signature returns the delegate signature, like "int32(int32,int32)".
+func (d *Delegate) Signature() string {
+	return d.sig.String()
+}
+
+// String returns the method name and its signature.
+func (d *Delegate) String() string {
+	return fmt.Sprintf("%s.%s %s", d.typ, d.method, d.sig)
+}
+
+// key returns the method of the delegate.
+func (d *Delegate) key() MethodKey {
+	return MethodKey{Assembly: d.assembly, Type: d.typ, Method: d.method}
+}
@@ -0,0 +1,306 @@
+package dotnet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestParseSignature(t *testing.T) {
+	valid := map[string]string{
+		"int32(int32,int32)":               "int32(int32,int32)",
+		" void ( ) ":                       "void()",
+		"float64(float64, float32, int32)": "float64(float64,float32,int32)",
+		"pointer(pointer,uintptr)":         "pointer(pointer,uintptr)",
+	}
+	for s, expected := range valid {
+		sig, err := parseSignature(s)
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+		if sig.String() != expected {
+			t.Fatalf("%q: got %q", s, sig)
+		}
+	}
+	invalid := []string{"", "int32", "int32(", "int(int)", "int32(void)", "int32(int32,)", "void(int8,int8,int8,int8,int8)"}
+	for _, s := range invalid {
+		if _, err := parseSignature(s); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("%q: got %v", s, err)
+		}
+	}
+}
+
+func TestSignatureTrampoline(t *testing.T) {
+	seen := make(map[int]string)
+	var walk func(params []kind)
+	walk = func(params []kind) {
+		for _, result := range []kind{kindVoid, kindInt32, kindFloat32, kindFloat64} {
+			sig := signature{result: result, params: params}
+			index := sig.trampoline()
+			if index < 0 || index >= delegateTrampolines {
+				t.Fatalf("%s: index %d out of range", sig, index)
+			}
+			if other, ok := seen[index]; ok {
+				t.Fatalf("%s and %s use trampoline %d", sig, other, index)
+			}
+			seen[index] = sig.String()
+		}
+		if len(params) == nativeArity {
+			return
+		}
+		for _, k := range []kind{kindInt64, kindFloat32, kindFloat64} {
+			walk(append(append([]kind(nil), params...), k))
+		}
+	}
+	walk(nil)
+	if len(seen) != delegateTrampolines {
+		t.Fatalf("Got %d trampolines, expected %d", len(seen), delegateTrampolines)
+	}
+}
+
+func TestDelegateArguments(t *testing.T) {
+	valid := []struct {
+		kind  kind
+		value interface{}
+		raw   uint64
+	}{
+		{kindInt8, -1, 0xffffffffffffffff},
+		{kindInt8, uint8(127), 127},
+		{kindUint8, 255, 255},
+		{kindInt32, int64(-2147483648), 0xffffffff80000000},
+		{kindUint64, uint64(1 << 63), 1 << 63},
+		{kindFloat64, float32(0.5), 0x3fe0000000000000},
+		{kindFloat32, 0.5, 0x3f000000},
+		{kindPointer, nil, 0},
+		{kindUintptr, uintptr(8), 8},
+	}
+	for _, v := range valid {
+		raw, err := v.kind.argument(v.value)
+		if err != nil || raw != v.raw {
+			t.Fatalf("%s %v: got 0x%x, %v", v.kind, v.value, raw, err)
+		}
+	}
+	invalid := []struct {
+		kind  kind
+		value interface{}
+	}{
+		{kindInt8, 128},
+		{kindInt8, uint64(1 << 63)},
+		{kindUint8, -1},
+		{kindUint16, 65536},
+		{kindInt32, "1"},
+		{kindFloat64, 1},
+		{kindPointer, 1},
+	}
+	for _, v := range invalid {
+		if _, err := v.kind.argument(v.value); !errors.Is(err, ErrInvalidArgument) {
+			t.Fatalf("%s %v: got %v", v.kind, v.value, err)
+		}
+	}
+}
+
+func TestDelegateCall(t *testing.T) {
+	calls := []struct {
+		method, signature string
+		args              []interface{}
+		expected          interface{}
+	}{
+		{"Add", "int32(int32,int32)", []interface{}{40, 2}, int32(42)},
+		{"Scale", "float64(float64,float32,int32)", []interface{}{1.5, float32(2), 3}, float64(9)},
+		{"Half", "float32(float32)", []interface{}{float32(5)}, float32(2.5)},
+		{"Mix", "int64(int8,int16,int64,uint8)", []interface{}{-1, 1000, int64(1) << 40, 255}, int64(1)<<40 + 1254},
+		{"Negate", "uint32(uint32)", []interface{}{0}, uint32(0xffffffff)},
+		{"SetExitCode", "void(int32)", []interface{}{0}, nil},
+	}
+	for _, c := range calls {
+		d, err := testRuntime.NewDelegate("Test", "Test.TestClass", c.method, c.signature)
+		if err != nil {
+			t.Fatalf("%s: %v", c.method, err)
+		}
+		result, err := d.Call(c.args...)
+		if err != nil {
+			t.Fatalf("%s: %v", d, err)
+		}
+		if result != c.expected {
+			t.Fatalf("%s: got %v (%T), expected %v", d, result, result, c.expected)
+		}
+	}
+
+	offset, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Offset", "pointer(pointer,int32)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 4)
+	result, err := offset.Call(unsafe.Pointer(&data[0]), 3)
+	if err != nil || result != unsafe.Pointer(&data[3]) {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+	if offset.Signature() != "pointer(pointer,int32)" || offset.Pointer() == nil {
+		t.Fatalf("Unexpected delegate %s", offset)
+	}
+
+	if _, err := offset.Call(nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Add", "int32(int32"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Missing", "void()"); !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+	// Each parameter and the result must match the managed method:
+	for _, test := range []struct{ method, signature, position string }{
+		{"Add", "int32(int32,int64)", "parameter 1"},
+		{"Add", "int64(int32,int32)", "the result"},
+		{"Add", "void(int32,int32)", "the result"},
+		{"Scale", "float64(float32,float32,int32)", "parameter 0"},
+		{"Negate", "uint32(int32)", "parameter 0"},
+		{"Print", "void(pointer)", "parameter 0"},
+		{"Shout", "string(string)", "parameter 0"},
+		{"Greet", "utf16string(string)", "the result"},
+		{"Offset", "pointer(int64,int32)", "parameter 0"},
+	} {
+		_, err := testRuntime.NewDelegate("Test", "Test.TestClass", test.method, test.signature)
+		if !errors.Is(err, ErrInvalidSignature) || !strings.Contains(err.Error(), test.position) {
+			t.Errorf("%s %s: got %v", test.method, test.signature, err)
+		}
+	}
+	if _, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Offset", "uintptr(uintptr,int32)"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type exitCode int32
+
+func TestBind(t *testing.T) {
+	add, err := Bind[func(int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Add")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := add(40, 2); result != 42 {
+		t.Fatalf("Got %d", result)
+	}
+	scale, err := Bind[func(float64, float32, int32) float64](testRuntime, "Test", "Test.TestClass", "Scale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := scale(1.5, 2, 3); result != 9 {
+		t.Fatalf("Got %v", result)
+	}
+	negate, err := Bind[func(uint32) uint32](testRuntime, "Test", "Test.TestClass", "Negate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := negate(1); result != 0xfffffffe {
+		t.Fatalf("Got %x", result)
+	}
+	offset, err := Bind[func(unsafe.Pointer, int32) unsafe.Pointer](testRuntime, "Test", "Test.TestClass", "Offset")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 4)
+	if result := offset(unsafe.Pointer(&data[0]), 2); result != unsafe.Pointer(&data[2]) {
+		t.Fatalf("Got %v", result)
+	}
+	setExitCode, err := Bind[func(exitCode)](testRuntime, "Test", "Test.TestClass", "SetExitCode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setExitCode(0)
+
+	if _, err := Bind[func(int, int) int](testRuntime, "Test", "Test.TestClass", "Add"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func([]string)](testRuntime, "Test", "Test.TestClass", "Print"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(int32, uint32) int32](testRuntime, "Test", "Test.TestClass", "Add"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	// Fill takes a byte*, a []float32 would be overrun:
+	if _, err := Bind[func([]float32, uint8)](testRuntime, "Test", "Test.TestClass", "Fill"); !errors.Is(err, ErrInvalidSignature) ||
+		!strings.Contains(err.Error(), "parameter 0 of Test.TestClass.Fill is *uint8") {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func() (int32, int32)](testRuntime, "Test", "Test.TestClass", "Add"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[int32](testRuntime, "Test", "Test.TestClass", "Add"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if f, err := Bind[func()](testRuntime, "Test", "Test.TestClass", "Missing"); !errors.Is(err, ErrMissingMethodException) || f != nil {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+func TestSliceDelegates(t *testing.T) {
+	sum, err := Bind[func([]int32) int64](testRuntime, "Test", "Test.TestClass", "SumInt32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := sum([]int32{1, -2, 3, 1 << 30, 1 << 30}); result != 2+1<<31 {
+		t.Fatalf("Got %d", result)
+	}
+	if result := sum(nil); result != 0 {
+		t.Fatalf("Got %d", result)
+	}
+
+	fill, err := Bind[func([]byte, uint8)](testRuntime, "Test", "Test.TestClass", "Fill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := make([]byte, 1<<20)
+	fill(buffer[1:len(buffer)-1], 7)
+	if buffer[0] != 0 || buffer[1] != 7 || buffer[len(buffer)-2] != 7 || buffer[len(buffer)-1] != 0 {
+		t.Fatalf("Got %v...%v", buffer[:2], buffer[len(buffer)-2:])
+	}
+
+	dot, err := Bind[func([]float64, []float64) float64](testRuntime, "Test", "Test.TestClass", "Dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := dot([]float64{1, 2, 3}, []float64{0.5, 0.25, 2}); result != 7 {
+		t.Fatalf("Got %v", result)
+	}
+
+	shift, err := Bind[func(testPoint, []int32) testPoint](testRuntime, "Test", "Test.TestClass", "ShiftBy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := shift(testPoint{1, 1}, []int32{2, 3}); p != (testPoint{6, -4}) {
+		t.Fatalf("Got %+v", p)
+	}
+
+	if _, err := Bind[func([]float64, []float64, int32) float64](testRuntime, "Test", "Test.TestClass", "Dot"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(testPoint, []int32, int32) testPoint](testRuntime, "Test", "Test.TestClass", "ShiftBy"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func([]testPoint)](testRuntime, "Test", "Test.TestClass", "Fill"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+func BenchmarkBindSlice(b *testing.B) {
+	fill, err := Bind[func([]byte, uint8)](testRuntime, "Test", "Test.TestClass", "Fill")
+	if err != nil {
+		b.Fatal(err)
+	}
+	buffer := make([]byte, 4<<20)
+	b.SetBytes(int64(len(buffer)))
+	for n := 0; n < b.N; n++ {
+		fill(buffer, uint8(n))
+	}
+}
+
+func BenchmarkBindAdd(b *testing.B) {
+	add, err := Bind[func(int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Add")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for n := 0; n < b.N; n++ {
+		add(1, 1)
+	}
+}
@@ -0,0 +1,195 @@
+package dotnet
+
+import "fmt"
+
+// Operation identifies the hosting call that failed.
+type Operation string
+
+// Hosting operations reported by HRESULTError.
+const (
+	OpInitialize      Operation = "initialize"
+	OpCreateDelegate  Operation = "create_delegate"
+	OpExecuteAssembly Operation = "execute_assembly"
+	OpShutdown        Operation = "shutdown"
+	OpLoadComponent   Operation = "load_component"
+	OpCreateCallback  Operation = "create_callback"
+	OpObject          Operation = "object"
+	OpTask            Operation = "task"
+	OpLoadAssembly    Operation = "load_assembly"
+	OpLoadPlugin      Operation = "load_plugin"
+	OpCallDelegate    Operation = "call_delegate"
+)
+
+// Common HRESULT values returned by the CoreCLR hosting APIs.
+const (
+	hrNotImpl                = 0x80004001
+	hrNoInterface            = 0x80004002
+	hrPointer                = 0x80004003
+	hrAbort                  = 0x80004004
+	hrFail                   = 0x80004005
+	hrFileNotFound           = 0x80070002
+	hrDirectoryNotFound      = 0x80070003
+	hrAccessDenied           = 0x80070005
+	hrBadImageFormat         = 0x8007000B
+	hrOutOfMemory            = 0x8007000E
+	hrInvalidArg             = 0x80070057
+	hrBadExeFormat           = 0x800700C1
+	hrAssemblyExpected       = 0x80131018
+	hrNewerRuntime           = 0x8013101B
+	hrHostInvalidOperation   = 0x80131022
+	hrRefDefMismatch         = 0x80131040
+	hrInvalidAssemblyName    = 0x80131047
+	hrException              = 0x80131500
+	hrInvalidOperation       = 0x80131509
+	hrSecurity               = 0x8013150A
+	hrMissingField           = 0x80131511
+	hrMissingMember          = 0x80131512
+	hrMissingMethod          = 0x80131513
+	hrTypeLoad               = 0x80131522
+	hrEntryPointNotFound     = 0x80131523
+	hrDllNotFound            = 0x80131524
+	hrPlatformNotSupported   = 0x80131539
+	hrTargetInvocation       = 0x80131604
+	hrIO                     = 0x80131620
+	hrFileLoad               = 0x80131621
+	hrFailFast               = 0x80131623
+	hrNullReferenceException = hrPointer
+)
+
+// Status codes returned by hostfxr, see the StatusCode enum of the dotnet host.
+const (
+	hrHostInvalidArg             = 0x80008081
+	hrHostLibLoadFailure         = 0x80008082
+	hrHostLibMissing             = 0x80008083
+	hrHostEntryPointFailure      = 0x80008084
+	hrHostCoreClrResolveFailure  = 0x80008087
+	hrHostCoreClrInitFailure     = 0x80008089
+	hrHostInvalidConfigFile      = 0x80008093
+	hrHostFrameworkMissing       = 0x80008096
+	hrHostAPIFailed              = 0x80008097
+	hrHostInvalidState           = 0x800080a3
+	hrHostPropertyNotFound       = 0x800080a4
+	hrHostIncompatibleConfig     = 0x800080a5
+	hrHostAPIUnsupportedScenario = 0x800080a6
+)
+
+// hresultInfo holds the symbolic name and a short description of an HRESULT.
+type hresultInfo struct {
+	name        string
+	description string
+}
+
+// hresults maps the most common CoreCLR and COR_E codes to their symbolic names.
+var hresults = map[uint32]hresultInfo{
+	hrNotImpl:              {"E_NOTIMPL", "not implemented"},
+	hrNoInterface:          {"E_NOINTERFACE", "interface not supported"},
+	hrPointer:              {"E_POINTER", "invalid pointer"},
+	hrAbort:                {"E_ABORT", "operation aborted"},
+	hrFail:                 {"E_FAIL", "unspecified failure"},
+	hrFileNotFound:         {"COR_E_FILENOTFOUND", "assembly not found"},
+	hrDirectoryNotFound:    {"COR_E_DIRECTORYNOTFOUND", "directory not found"},
+	hrAccessDenied:         {"E_ACCESSDENIED", "access denied"},
+	hrBadImageFormat:       {"COR_E_BADIMAGEFORMAT", "bad image format"},
+	hrOutOfMemory:          {"E_OUTOFMEMORY", "out of memory"},
+	hrInvalidArg:           {"E_INVALIDARG", "invalid argument"},
+	hrBadExeFormat:         {"COR_E_BADEXEFORMAT", "bad executable format"},
+	hrAssemblyExpected:     {"COR_E_ASSEMBLYEXPECTED", "module is not an assembly"},
+	hrNewerRuntime:         {"COR_E_NEWER_RUNTIME", "assembly built for a newer runtime"},
+	hrHostInvalidOperation: {"HOST_E_INVALIDOPERATION", "invalid host operation, the runtime may already be initialized"},
+	hrRefDefMismatch:       {"FUSION_E_REF_DEF_MISMATCH", "assembly reference does not match definition"},
+	hrInvalidAssemblyName:  {"FUSION_E_INVALID_NAME", "invalid assembly name"},
+	hrException:            {"COR_E_EXCEPTION", "managed exception"},
+	hrInvalidOperation:     {"COR_E_INVALIDOPERATION", "invalid operation"},
+	hrSecurity:             {"COR_E_SECURITY", "security error"},
+	hrMissingField:         {"COR_E_MISSINGFIELD", "missing field"},
+	hrMissingMember:        {"COR_E_MISSINGMEMBER", "missing member"},
+	hrMissingMethod:        {"COR_E_MISSINGMETHOD", "missing method"},
+	hrTypeLoad:             {"COR_E_TYPELOAD", "missing type"},
+	hrEntryPointNotFound:   {"COR_E_ENTRYPOINTNOTFOUND", "entry point not found"},
+	hrDllNotFound:          {"COR_E_DLLNOTFOUND", "library not found"},
+	hrPlatformNotSupported: {"COR_E_PLATFORMNOTSUPPORTED", "platform not supported"},
+	hrTargetInvocation:     {"COR_E_TARGETINVOCATION", "exception thrown by invocation target"},
+	hrIO:                   {"COR_E_IO", "I/O error"},
+	hrFileLoad:             {"COR_E_FILELOAD", "assembly could not be loaded"},
+	hrFailFast:             {"COR_E_FAILFAST", "runtime failed fast"},
+
+	hrHostInvalidArg:             {"InvalidArgFailure", "invalid hostfxr argument"},
+	hrHostLibLoadFailure:         {"CoreHostLibLoadFailure", "hostpolicy could not be loaded"},
+	hrHostLibMissing:             {"CoreHostLibMissingFailure", "hostpolicy not found"},
+	hrHostEntryPointFailure:      {"CoreHostEntryPointFailure", "hostpolicy entry point not found"},
+	hrHostCoreClrResolveFailure:  {"CoreClrResolveFailure", "libcoreclr not found"},
+	hrHostCoreClrInitFailure:     {"CoreClrInitFailure", "runtime initialization failed"},
+	hrHostInvalidConfigFile:      {"InvalidConfigFile", "invalid runtimeconfig.json or deps.json file"},
+	hrHostFrameworkMissing:       {"FrameworkMissingFailure", "framework not found"},
+	hrHostAPIFailed:              {"HostApiFailed", "hostfxr call failed"},
+	hrHostInvalidState:           {"HostInvalidState", "invalid host state"},
+	hrHostPropertyNotFound:       {"HostPropertyNotFound", "runtime property not found"},
+	hrHostIncompatibleConfig:     {"CoreHostIncompatibleConfig", "runtimeconfig.json is incompatible with the loaded runtime"},
+	hrHostAPIUnsupportedScenario: {"HostApiUnsupportedScenario", "unsupported hostfxr scenario"},
+}
+
+var (
+	// ErrAssemblyNotFound is returned when the requested assembly can't be found.
+	ErrAssemblyNotFound = &HRESULTError{Code: hrFileNotFound}
+	// ErrTypeLoadException is returned when the requested type can't be loaded.
+	ErrTypeLoadException = &HRESULTError{Code: hrTypeLoad}
+	// ErrMissingMethodException is returned when the requested method doesn't exist.
+	ErrMissingMethodException = &HRESULTError{Code: hrMissingMethod}
+	// ErrNullReferenceException is returned when the delegate function pointer is invalid.
+	ErrNullReferenceException = &HRESULTError{Code: hrNullReferenceException}
+	// ErrFileLoadException is returned when an assembly is found but can't be loaded.
+	ErrFileLoadException = &HRESULTError{Code: hrFileLoad}
+	// ErrBadImageFormat is returned when an assembly or the runtime library has an invalid format.
+	ErrBadImageFormat = &HRESULTError{Code: hrBadImageFormat}
+	// ErrRuntimeAlreadyInitialized is returned when CoreCLR was already initialized in this process.
+	ErrRuntimeAlreadyInitialized = &HRESULTError{Code: hrHostInvalidOperation}
+	// ErrLibraryNotFound is returned when the runtime library can't be loaded.
+	ErrLibraryNotFound = &HRESULTError{Code: hrDllNotFound}
+	// ErrEntryPointNotFound is returned when the runtime library doesn't export a hosting function.
+	ErrEntryPointNotFound = &HRESULTError{Code: hrEntryPointNotFound}
+)
+
+// HRESULTError is returned when a hosting API call fails with an HRESULT.
+// Use errors.Is with the exported Err values to check for a specific code.
+type HRESULTError struct {
+	// Op is the hosting operation that failed, empty for the package sentinels.
+	Op Operation
+	// Code is the raw HRESULT value.
+	Code uint32
+}
+
+// newHRESULTError wraps a failed HRESULT returned by a cgo call.
+func newHRESULTError(op Operation, result int32) *HRESULTError {
+	return &HRESULTError{Op: op, Code: uint32(result)}
+}
+
+// Name returns the symbolic name of the HRESULT, like COR_E_TYPELOAD.
+// Unknown codes are rendered in hexadecimal.
+func (e *HRESULTError) Name() string {
+	if info, ok := hresults[e.Code]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("0x%08x", e.Code)
+}
+
+// Error implements the error interface.
+func (e *HRESULTError) Error() string {
+	description := "hosting error"
+	if info, ok := hresults[e.Code]; ok {
+		description = info.description
+	}
+	if e.Op == "" {
+		return fmt.Sprintf("%s (%s, 0x%08x)", description, e.Name(), e.Code)
+	}
+	return fmt.Sprintf("%s failed: %s (%s, 0x%08x)", e.Op, description, e.Name(), e.Code)
+}
+
+// Is reports whether target is an HRESULTError with the same code.
+// An empty target Op matches any operation.
+func (e *HRESULTError) Is(target error) bool {
+	t, ok := target.(*HRESULTError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code && (t.Op == "" || t.Op == e.Op)
+}
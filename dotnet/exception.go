@@ -0,0 +1,64 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"errors"
+	"unsafe"
+)
+
+// ErrManagedException matches every ManagedException.
+var ErrManagedException = errors.New("Managed exception")
+
+// ManagedException is returned when a method called through a Delegate or a function created by Bind throws.
+// The exception is caught by the wrapper that the helper assembly emits for the method, the runtime keeps going.
+//
+// errors.Is matches ErrManagedException and the HRESULTError sentinels with the same HResult, like
+// ErrNullReferenceException for a System.NullReferenceException.
+type ManagedException struct {
+	// Type is the full name of the exception type, like System.ArgumentException.
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	HResult int32  `json:"hresult"`
+	// StackTrace is the managed stack trace, from the method that threw to the bound method.
+	StackTrace string `json:"stackTrace"`
+	// InnerExceptions holds the InnerException, or every inner exception of an AggregateException.
+	InnerExceptions []*ManagedException `json:"innerExceptions"`
+}
+
+// newManagedException decodes and releases an exception stored by GoDotnet.Host.StoreException.
+func newManagedException(p unsafe.Pointer) *ManagedException {
+	description := UTF8ToString(p)
+	FreeCoTaskMem(p)
+	e := &ManagedException{}
+	if err := json.Unmarshal([]byte(description), e); err != nil {
+		return &ManagedException{Type: "System.Exception", Message: description, HResult: int32(hrException - 1<<32)}
+	}
+	return e
+}
+
+// Error implements the error interface.
+func (e *ManagedException) Error() string {
+	return e.Type + ": " + e.Message
+}
+
+// Unwrap returns the first inner exception.
+func (e *ManagedException) Unwrap() error {
+	if len(e.InnerExceptions) == 0 {
+		return nil
+	}
+	return e.InnerExceptions[0]
+}
+
+// Is reports whether target is ErrManagedException or an HRESULTError with the same code and no operation.
+func (e *ManagedException) Is(target error) bool {
+	if target == ErrManagedException {
+		return true
+	}
+	t, ok := target.(*HRESULTError)
+	return ok && t.Op == "" && t.Code == uint32(e.HResult)
+}
+
+// canceled reports whether the exception is an OperationCanceledException, thrown by canceled tasks.
+func (e *ManagedException) canceled() bool {
+	return e.Type == "System.OperationCanceledException" || e.Type == "System.Threading.Tasks.TaskCanceledException"
+}
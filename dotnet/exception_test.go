@@ -0,0 +1,77 @@
+package dotnet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestManagedException(t *testing.T) {
+	divide, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Divide", "int32(int32,int32)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = divide.Call(1, 0)
+	var e *ManagedException
+	if !errors.As(err, &e) || e.Type != "System.DivideByZeroException" || e.HResult != -2147352558 || !errors.Is(err, ErrManagedException) {
+		t.Fatalf("Got %v", err)
+	}
+	if !strings.Contains(e.StackTrace, "Test.TestClass.Divide") {
+		t.Fatalf("Got stack trace %q", e.StackTrace)
+	}
+	// The runtime keeps going:
+	if result, err := divide.Call(42, 2); err != nil || result != int32(21) {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+
+	length, err := Bind[func(unsafe.Pointer) (int32, error)](testRuntime, "Test", "Test.TestClass", "Length")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := length(nil); !errors.Is(err, ErrNullReferenceException) {
+		t.Fatalf("Got %v", err)
+	}
+	s := StringToUTF8("four")
+	defer FreeCoTaskMem(s)
+	if n, err := length(s); n != 4 || err != nil {
+		t.Fatalf("Got %d, %v", n, err)
+	}
+
+	fail, err := Bind[func(string) (string, error)](testRuntime, "Test", "Test.TestClass", "Fail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := fail("broken")
+	if result != "" || !errors.As(err, &e) || err.Error() != "System.InvalidOperationException: broken" {
+		t.Fatalf("Got %q, %v", result, err)
+	}
+	var inner *ManagedException
+	if !errors.As(errors.Unwrap(err), &inner) || inner.Type != "System.ArgumentException" || !strings.HasPrefix(inner.Message, "inner broken") {
+		t.Fatalf("Got %+v", e.InnerExceptions)
+	}
+
+	failAll, err := Bind[func(testPoint) (testPoint, error)](testRuntime, "Test", "Test.TestClass", "FailAll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := failAll(testPoint{1, 2})
+	if p != (testPoint{}) || !errors.As(err, &e) || e.Type != "System.AggregateException" || len(e.InnerExceptions) != 2 ||
+		e.InnerExceptions[0].Type != "System.FormatException" || e.InnerExceptions[1].Type != "System.TimeoutException" {
+		t.Fatalf("Got %+v, %v", p, err)
+	}
+
+	panics, err := Bind[func(int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Divide")
+	if err != nil {
+		t.Fatal(err)
+	}
+	func() {
+		defer func() {
+			if err, ok := recover().(error); !ok || !errors.Is(err, ErrManagedException) {
+				t.Fatalf("Got %v", err)
+			}
+		}()
+		panics(1, 0)
+		t.Fatal("Expected a panic")
+	}()
+}
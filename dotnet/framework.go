@@ -0,0 +1,420 @@
+package dotnet
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// frameworkName is the name of the shared framework used to host the runtime.
+	frameworkName = "Microsoft.NETCore.App"
+)
+
+var (
+	// ErrFrameworkNotFound is returned when no installed framework satisfies the requested version.
+	ErrFrameworkNotFound = errors.New("No compatible framework found")
+
+	// installLocationDir holds the install_location files written by the .NET installers.
+	installLocationDir = "/etc/dotnet"
+
+	linuxDefaultRoots = []string{
+		"/usr/share/dotnet",
+		"$HOME/.dotnet",
+	}
+
+	darwinDefaultRoots = []string{
+		"/usr/local/share/dotnet",
+		"$HOME/.dotnet",
+	}
+
+	// dotnetArchs maps GOARCH values to the architecture names used by .NET.
+	dotnetArchs = map[string]string{
+		"386":     "x86",
+		"amd64":   "x64",
+		"arm":     "arm",
+		"arm64":   "arm64",
+		"ppc64le": "ppc64le",
+		"s390x":   "s390x",
+		"riscv64": "riscv64",
+	}
+)
+
+// Version is a semantic version, as used by the shared framework directory names.
+type Version struct {
+	Major, Minor, Patch int
+	// Prerelease holds the pre-release tag, like "preview.7.24405.7", empty for releases.
+	Prerelease string
+}
+
+// ParseVersion parses a version like "8.0.1" or "9.0.0-rc.1.24431.7".
+// Build metadata is ignored and a missing patch number defaults to zero.
+func ParseVersion(s string) (v Version, err error) {
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		v.Prerelease = s[i+1:]
+		s = s[:i]
+		if v.Prerelease == "" {
+			return Version{}, fmt.Errorf("Invalid version %q: empty pre-release tag", s)
+		}
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("Invalid version %q", s)
+	}
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("Invalid version %q", s)
+		}
+		*numbers[i] = n
+	}
+	return v, nil
+}
+
+// String returns the version in its canonical form.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// IsPrerelease reports whether v has a pre-release tag.
+func (v Version) IsPrerelease() bool {
+	return v.Prerelease != ""
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower, equal or higher than o,
+// following the semantic versioning precedence rules.
+func (v Version) Compare(o Version) int {
+	if c := compareInts(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := compareInts(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := compareInts(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares pre-release tags, a release always has a higher precedence.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if c := compareInts(an, bn); c != 0 {
+				return c
+			}
+		case aErr == nil:
+			// Numeric identifiers have lower precedence than alphanumeric ones:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInts(len(as), len(bs))
+}
+
+// RollForward controls which installed framework satisfies a requested version.
+// The policies match the rollForward setting understood by hostfxr.
+type RollForward int
+
+const (
+	// RollForwardMinor rolls forward to the lowest higher minor version when the requested
+	// minor version is missing. This is the default, as in hostfxr.
+	RollForwardMinor RollForward = iota
+	// RollForwardLatestPatch rolls forward to the highest patch of the requested major.minor version.
+	RollForwardLatestPatch
+	// RollForwardMajor rolls forward to the lowest higher major version when the requested
+	// major version is missing.
+	RollForwardMajor
+	// RollForwardLatestMinor rolls forward to the highest minor version of the requested major version.
+	RollForwardLatestMinor
+	// RollForwardLatestMajor rolls forward to the highest installed version, use it to express
+	// a minimum version.
+	RollForwardLatestMajor
+	// RollForwardDisable only accepts the exact requested version.
+	RollForwardDisable
+)
+
+var rollForwardNames = map[RollForward]string{
+	RollForwardMinor:       "Minor",
+	RollForwardLatestPatch: "LatestPatch",
+	RollForwardMajor:       "Major",
+	RollForwardLatestMinor: "LatestMinor",
+	RollForwardLatestMajor: "LatestMajor",
+	RollForwardDisable:     "Disable",
+}
+
+// String returns the hostfxr name of the policy.
+func (p RollForward) String() string {
+	if name, ok := rollForwardNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("RollForward(%d)", int(p))
+}
+
+// ParseRollForward parses a hostfxr roll forward policy name, case insensitive.
+func ParseRollForward(s string) (RollForward, error) {
+	for p, name := range rollForwardNames {
+		if strings.EqualFold(name, s) {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("Unknown roll forward policy %q", s)
+}
+
+// Framework describes an installed shared framework.
+type Framework struct {
+	Name    string
+	Version Version
+	Path    string
+}
+
+// locateFrameworks finds the installed versions of a shared framework, directories that aren't named
+// after a version are skipped. The install locations are searched in the same order as the dotnet muxer
+// does, see dotnetRoots, and the first one that contains the framework is used.
+func locateFrameworks(name string) (frameworks []Framework) {
+	for _, root := range dotnetRoots() {
+		frameworks = frameworksIn(name, filepath.Join(root, "shared", name))
+		if len(frameworks) > 0 {
+			return frameworks
+		}
+	}
+	return nil
+}
+
+// dotnetRoots returns the candidate .NET install locations, in this order:
+//
+//	DOTNET_ROOT_<ARCH>, like DOTNET_ROOT_X64
+//	DOTNET_ROOT
+//	/etc/dotnet/install_location_<arch>
+//	/etc/dotnet/install_location
+//	the directory of the dotnet executable found in PATH
+//	the default install locations for the current OS
+func dotnetRoots() (roots []string) {
+	arch := dotnetArchs[runtime.GOARCH]
+	if arch != "" {
+		if root := os.Getenv("DOTNET_ROOT_" + strings.ToUpper(arch)); root != "" {
+			roots = append(roots, root)
+		}
+	}
+	if root := os.Getenv("DOTNET_ROOT"); root != "" {
+		roots = append(roots, root)
+	}
+	if arch != "" {
+		if root := readInstallLocation(filepath.Join(installLocationDir, "install_location_"+arch)); root != "" {
+			roots = append(roots, root)
+		}
+	}
+	if root := readInstallLocation(filepath.Join(installLocationDir, "install_location")); root != "" {
+		roots = append(roots, root)
+	}
+	if muxerPath, err := exec.LookPath("dotnet"); err == nil {
+		// Package managers usually link the muxer into a bin directory:
+		if resolved, err := filepath.EvalSymlinks(muxerPath); err == nil {
+			muxerPath = resolved
+		}
+		roots = append(roots, filepath.Dir(muxerPath))
+	}
+
+	var defaultRoots []string
+	switch runtime.GOOS {
+	case "darwin":
+		defaultRoots = darwinDefaultRoots
+	case "linux":
+		defaultRoots = linuxDefaultRoots
+	}
+	// Replace HOME env var from default roots:
+	homeEnv := os.Getenv("HOME")
+	for _, root := range defaultRoots {
+		roots = append(roots, strings.Replace(root, "$HOME", homeEnv, 1))
+	}
+	return roots
+}
+
+// readInstallLocation returns the first line of an install_location file, empty if it can't be read.
+func readInstallLocation(path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	line := strings.SplitN(string(data), "\n", 2)[0]
+	return strings.TrimSpace(line)
+}
+
+// frameworksIn lists the framework versions installed in a shared framework directory.
+func frameworksIn(name, basePath string) (frameworks []Framework) {
+	directories, err := ioutil.ReadDir(basePath)
+	if err != nil {
+		return nil
+	}
+	for _, d := range directories {
+		if !d.IsDir() {
+			continue
+		}
+		version, err := ParseVersion(d.Name())
+		if err != nil {
+			continue
+		}
+		frameworks = append(frameworks, Framework{
+			Name:    name,
+			Version: version,
+			Path:    filepath.Join(basePath, d.Name()),
+		})
+	}
+	return frameworks
+}
+
+// selectFramework picks the framework that satisfies the requested version using the given policy.
+// When no version is requested the highest release is used, falling back to the highest pre-release.
+// Pre-release frameworks are only considered when the requested version is a pre-release too.
+func selectFramework(name string, frameworks []Framework, requested string, policy RollForward) (Framework, error) {
+	candidates := make([]Framework, len(frameworks))
+	copy(candidates, frameworks)
+	// Stable so that the first discovered path wins when a version is installed twice:
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Version.Compare(candidates[j].Version) < 0
+	})
+
+	if requested == "" {
+		for i := len(candidates) - 1; i >= 0; i-- {
+			if !candidates[i].Version.IsPrerelease() {
+				return firstWithVersion(candidates, candidates[i].Version), nil
+			}
+		}
+		if len(candidates) > 0 {
+			return candidates[len(candidates)-1], nil
+		}
+		return Framework{}, fmt.Errorf("%w: %s", ErrFrameworkNotFound, name)
+	}
+
+	v, err := ParseVersion(requested)
+	if err != nil {
+		return Framework{}, err
+	}
+
+	var eligible []Framework
+	for _, f := range candidates {
+		if f.Version.Compare(v) < 0 {
+			continue
+		}
+		if f.Version.IsPrerelease() && !v.IsPrerelease() {
+			continue
+		}
+		eligible = append(eligible, f)
+	}
+
+	notFound := fmt.Errorf("%w: %s %s (roll forward: %s)", ErrFrameworkNotFound, name, v, policy)
+
+	sameMinor := func(f Framework) bool { return f.Version.Major == v.Major && f.Version.Minor == v.Minor }
+	sameMajor := func(f Framework) bool { return f.Version.Major == v.Major }
+	anyVersion := func(Framework) bool { return true }
+
+	switch policy {
+	case RollForwardDisable:
+		for _, f := range eligible {
+			if f.Version.Compare(v) == 0 {
+				return f, nil
+			}
+		}
+	case RollForwardLatestPatch:
+		if f, ok := latest(eligible, sameMinor); ok {
+			return f, nil
+		}
+	case RollForwardMinor, RollForwardMajor:
+		if f, ok := latest(eligible, sameMinor); ok {
+			return f, nil
+		}
+		// Roll to the lowest higher minor (or major) version and then to its latest patch:
+		rollable := sameMajor
+		if policy == RollForwardMajor {
+			rollable = anyVersion
+		}
+		for _, f := range eligible {
+			if rollable(f) {
+				return latestPatch(eligible, f), nil
+			}
+		}
+	case RollForwardLatestMinor:
+		if f, ok := latest(eligible, sameMajor); ok {
+			return f, nil
+		}
+	case RollForwardLatestMajor:
+		if f, ok := latest(eligible, anyVersion); ok {
+			return f, nil
+		}
+	default:
+		return Framework{}, fmt.Errorf("Unknown roll forward policy %s", policy)
+	}
+	return Framework{}, notFound
+}
+
+// latest returns the highest framework matching the filter, frameworks must be sorted.
+func latest(frameworks []Framework, filter func(Framework) bool) (Framework, bool) {
+	for i := len(frameworks) - 1; i >= 0; i-- {
+		if filter(frameworks[i]) {
+			return firstWithVersion(frameworks, frameworks[i].Version), true
+		}
+	}
+	return Framework{}, false
+}
+
+// latestPatch returns the highest framework that shares the major and minor version of f.
+func latestPatch(frameworks []Framework, f Framework) Framework {
+	result, _ := latest(frameworks, func(c Framework) bool {
+		return c.Version.Major == f.Version.Major && c.Version.Minor == f.Version.Minor
+	})
+	return result
+}
+
+// firstWithVersion returns the first discovered framework with the given version, frameworks must be sorted
+// with a stable sort so that it comes first among the frameworks installed twice. It returns an empty
+// Framework when the version isn't found.
+func firstWithVersion(frameworks []Framework, v Version) Framework {
+	for _, f := range frameworks {
+		if f.Version.Compare(v) == 0 {
+			return f
+		}
+	}
+	return Framework{}
+}
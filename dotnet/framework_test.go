@@ -0,0 +1,207 @@
+package dotnet
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Version
+	}{
+		{"8.0.20", Version{8, 0, 20, ""}},
+		{"2.1", Version{2, 1, 0, ""}},
+		{"9.0.0-preview.7.24405.7", Version{9, 0, 0, "preview.7.24405.7"}},
+		{"3.1.0+build.5", Version{3, 1, 0, ""}},
+	}
+	for _, test := range tests {
+		v, err := ParseVersion(test.input)
+		if err != nil {
+			t.Fatalf("ParseVersion(%q) failed: %s", test.input, err)
+		}
+		if v != test.expected {
+			t.Fatalf("ParseVersion(%q) returned %+v, expected %+v", test.input, v, test.expected)
+		}
+	}
+	for _, input := range []string{"", "8", "a.b.c", "1.2.3.4", "1.0.0-", "-1.0.0"} {
+		if _, err := ParseVersion(input); err == nil {
+			t.Fatalf("ParseVersion(%q) didn't fail", input)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	// Ordered by precedence, as in the semver spec:
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.2.0",
+		"10.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := ParseVersion(ordered[i])
+			b, _ := ParseVersion(ordered[j])
+			expected := compareInts(i, j)
+			if c := a.Compare(b); c != expected {
+				t.Fatalf("%s compared to %s returned %d, expected %d", a, b, c, expected)
+			}
+		}
+	}
+}
+
+func TestSelectFramework(t *testing.T) {
+	var frameworks []Framework
+	for _, v := range []string{"2.1.5", "3.1.32", "3.1.2", "6.0.36", "6.2.1", "6.2.3", "8.0.20", "9.0.0-rc.1"} {
+		version, _ := ParseVersion(v)
+		frameworks = append(frameworks, Framework{Name: frameworkName, Version: version, Path: v})
+	}
+	tests := []struct {
+		requested string
+		policy    RollForward
+		expected  string
+	}{
+		{"", RollForwardMinor, "8.0.20"},
+		{"3.1.0", RollForwardMinor, "3.1.32"},
+		{"3.1.0", RollForwardLatestPatch, "3.1.32"},
+		{"3.1.2", RollForwardDisable, "3.1.2"},
+		{"3.1.3", RollForwardDisable, ""},
+		{"3.0.0", RollForwardLatestPatch, ""},
+		{"3.0.0", RollForwardMinor, "3.1.32"},
+		{"6.1.0", RollForwardMinor, "6.2.3"},
+		{"6.0.0", RollForwardLatestMinor, "6.2.3"},
+		{"4.0.0", RollForwardMinor, ""},
+		{"4.0.0", RollForwardMajor, "6.0.36"},
+		{"6.0.0", RollForwardMajor, "6.0.36"},
+		{"2.1.0", RollForwardLatestMajor, "8.0.20"},
+		{"9.0.0-preview.1", RollForwardMinor, "9.0.0-rc.1"},
+		{"8.1.0", RollForwardLatestMajor, ""},
+	}
+	for _, test := range tests {
+		f, err := selectFramework(frameworkName, frameworks, test.requested, test.policy)
+		if test.expected == "" {
+			if !errors.Is(err, ErrFrameworkNotFound) {
+				t.Fatalf("selectFramework(%q, %s) returned %v, expected ErrFrameworkNotFound", test.requested, test.policy, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("selectFramework(%q, %s) failed: %s", test.requested, test.policy, err)
+		}
+		if f.Version.String() != test.expected {
+			t.Fatalf("selectFramework(%q, %s) returned %s, expected %s", test.requested, test.policy, f.Version, test.expected)
+		}
+	}
+}
+
+func TestParseRollForward(t *testing.T) {
+	for p, name := range rollForwardNames {
+		parsed, err := ParseRollForward(name)
+		if err != nil || parsed != p {
+			t.Fatalf("ParseRollForward(%q) returned %s, %v", name, parsed, err)
+		}
+	}
+	if _, err := ParseRollForward("Sideways"); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+// fakeRoot creates a .NET install location with the given framework versions.
+func fakeRoot(t *testing.T, versions ...string) string {
+	root, err := ioutil.TempDir("", "dotnet-root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range versions {
+		if err := os.MkdirAll(filepath.Join(root, "shared", frameworkName, v), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "dotnet"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+// setenv sets an environment variable until the test finishes.
+func setenv(t *testing.T, key, value string) {
+	previous, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestLocateFrameworks(t *testing.T) {
+	arch := dotnetArchs[runtime.GOARCH]
+	if arch == "" {
+		t.Skipf("Unsupported architecture %s", runtime.GOARCH)
+	}
+	archRoot := fakeRoot(t, "1.0.0")
+	envRoot := fakeRoot(t, "2.0.0")
+	archLocationRoot := fakeRoot(t, "3.0.0")
+	locationRoot := fakeRoot(t, "4.0.0")
+	pathRoot := fakeRoot(t, "5.0.0")
+	emptyRoot := fakeRoot(t)
+	etc, err := ioutil.TempDir("", "etc-dotnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	binDir, err := ioutil.TempDir("", "bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		for _, dir := range []string{archRoot, envRoot, archLocationRoot, locationRoot, pathRoot, emptyRoot, etc, binDir} {
+			os.RemoveAll(dir)
+		}
+	}()
+	if err := os.Symlink(filepath.Join(pathRoot, "dotnet"), filepath.Join(binDir, "dotnet")); err != nil {
+		t.Fatal(err)
+	}
+	previousLocationDir := installLocationDir
+	installLocationDir = etc
+	defer func() { installLocationDir = previousLocationDir }()
+
+	setenv(t, "PATH", binDir)
+	setenv(t, "DOTNET_ROOT", envRoot)
+	setenv(t, "DOTNET_ROOT_"+strings.ToUpper(arch), archRoot)
+	ioutil.WriteFile(filepath.Join(etc, "install_location_"+arch), []byte(archLocationRoot+"\n"), 0644)
+	ioutil.WriteFile(filepath.Join(etc, "install_location"), []byte(locationRoot+"\nignored\n"), 0644)
+
+	// Each step removes the location that should have been found:
+	steps := []struct {
+		expected string
+		next     func()
+	}{
+		{"1.0.0", func() { os.Setenv("DOTNET_ROOT_"+strings.ToUpper(arch), emptyRoot) }},
+		{"2.0.0", func() { os.Unsetenv("DOTNET_ROOT") }},
+		{"3.0.0", func() { os.Remove(filepath.Join(etc, "install_location_"+arch)) }},
+		{"4.0.0", func() { os.Remove(filepath.Join(etc, "install_location")) }},
+		{"5.0.0", func() {}},
+	}
+	for _, step := range steps {
+		frameworks := locateFrameworks(frameworkName)
+		if len(frameworks) != 1 || frameworks[0].Version.String() != step.expected {
+			t.Fatalf("Expected framework %s, got %+v", step.expected, frameworks)
+		}
+		step.next()
+	}
+}
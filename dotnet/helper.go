@@ -0,0 +1,84 @@
+package dotnet
+
+/*
+#include <stdlib.h>
+#include "hostfxr.hpp"
+*/
+import "C"
+
+import (
+	"crypto/sha256"
+	_ "embed" // for the helper assembly
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"unsafe"
+)
+
+//go:generate dotnet build shim/GoDotnet.csproj -c Release
+
+// helperAssembly is the GoDotnet helper, it implements CreateDelegate and ExecuteAssembly on top of hostfxr
+// and the managed side of the package features, like the Console redirection.
+//
+//go:embed shim/GoDotnet.dll
+var helperAssembly []byte
+
+const (
+	helperAssemblyName = "GoDotnet"
+	helperTypeName     = "GoDotnet.Host"
+)
+
+// extractHelper writes the embedded helper assembly to the user cache directory, the directory
+// is named after its hash so that different package versions don't overwrite each other.
+func extractHelper() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+	hash := sha256.Sum256(helperAssembly)
+	dir := filepath.Join(cacheDir, "go-dotnet", hex.EncodeToString(hash[:8]))
+	path := filepath.Join(dir, helperAssemblyName+".dll")
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	return path, writeFileAtomic(path, helperAssembly)
+}
+
+// helperFunction returns a GoDotnet.Host entry point, every entry point takes a pointer to its
+// arguments and their size and returns an HRESULT, see callHelper.
+func (r *Runtime) helperFunction(method string) (unsafe.Pointer, error) {
+	var f unsafe.Pointer
+	if r.Params.Backend != BackendHostFXR {
+		return f, r.createDelegate(helperAssemblyName, helperTypeName, method, &f)
+	}
+	helperPath := C.CString(r.helperPath)
+	methodName := C.CString(method)
+	result := C.getHelperFunction(&r.fxr, helperPath, methodName, &f)
+	C.free(unsafe.Pointer(helperPath))
+	C.free(unsafe.Pointer(methodName))
+	if result < 0 {
+		return nil, newHRESULTError(OpCreateDelegate, int32(result))
+	}
+	return f, nil
+}
+
+// callHelper calls a helper entry point, args points to a C struct.
+func callHelper(op Operation, f unsafe.Pointer, args unsafe.Pointer, size uintptr) error {
+	if result := C.callHelperFunction(f, args, C.int(size)); result < 0 {
+		return newHRESULTError(op, int32(result))
+	}
+	return nil
+}
+
+// managedExitCode returns Environment.ExitCode.
+func (r *Runtime) managedExitCode() (int, error) {
+	getExitCode, err := r.helperFunction("GetExitCode")
+	if err != nil {
+		return 0, err
+	}
+	var exitCode C.int
+	if err := callHelper(OpShutdown, getExitCode, unsafe.Pointer(&exitCode), unsafe.Sizeof(exitCode)); err != nil {
+		return 0, err
+	}
+	return int(exitCode), nil
+}
@@ -0,0 +1,191 @@
+#include <stdio.h>
+#include <dlfcn.h>
+
+#include "hostfxr.h"
+#include "coreclr_delegates.h"
+
+#include "hostfxr.hpp"
+#include "runtime.hpp"
+
+// goHostFXRError is exported by hostfxr.go:
+extern "C" void goHostFXRError(char* message);
+
+static void hostfxrErrorWriter(const char_t* message) {
+  goHostFXRError((char*)message);
+}
+
+// errorWriterScope sends the errors reported by hostfxr and hostpolicy on the current thread
+// to goHostFXRError while it's alive, instead of printing them to stderr.
+struct errorWriterScope {
+  hostfxr_set_error_writer_fn setErrorWriter;
+  hostfxr_error_writer_fn previous;
+
+  errorWriterScope(hostfxrHost* host) : setErrorWriter((hostfxr_set_error_writer_fn)host->setErrorWriter), previous(nullptr) {
+    if (setErrorWriter != nullptr) {
+      previous = setErrorWriter(hostfxrErrorWriter);
+    }
+  }
+  ~errorWriterScope() {
+    if (setErrorWriter != nullptr) {
+      setErrorWriter(previous);
+    }
+  }
+};
+
+// The helper type and its entry points, see shim/GoDotnet.cs:
+static const char* helperTypeName = "GoDotnet.Host, GoDotnet";
+
+// Argument layouts shared with the helper assembly:
+struct createDelegateArgs {
+  const char* assemblyName;
+  const char* typeName;
+  const char* methodName;
+  void** result;
+};
+
+struct executeAssemblyArgs {
+  const char* path;
+  int argc;
+  const char** argv;
+  unsigned int* exitCode;
+};
+
+int initializeHostFXR(hostfxrHost* host,
+            const char* hostfxrPath,
+            const char* runtimeConfigPath,
+            const char* hostPath,
+            const char* dotnetRoot) {
+
+  host->hostfxrLib = dlopen(hostfxrPath, RTLD_NOW | RTLD_LOCAL);
+  if (host->hostfxrLib == nullptr)
+  {
+      setHostError(&host->error, "dlopen", "dlopen failed to open the libhostfxr.so with error %s", dlerror());
+      return COR_E_DLLNOTFOUND;
+  }
+
+  host->initializeForRuntimeConfig = dlsym(host->hostfxrLib, "hostfxr_initialize_for_runtime_config");
+  host->getRuntimeDelegate = dlsym(host->hostfxrLib, "hostfxr_get_runtime_delegate");
+  host->getRuntimePropertyValue = dlsym(host->hostfxrLib, "hostfxr_get_runtime_property_value");
+  host->setRuntimePropertyValue = dlsym(host->hostfxrLib, "hostfxr_set_runtime_property_value");
+  host->close = dlsym(host->hostfxrLib, "hostfxr_close");
+  host->setErrorWriter = dlsym(host->hostfxrLib, "hostfxr_set_error_writer");
+
+  if (host->initializeForRuntimeConfig == nullptr || host->getRuntimeDelegate == nullptr ||
+      host->getRuntimePropertyValue == nullptr || host->setRuntimePropertyValue == nullptr ||
+      host->close == nullptr)
+  {
+      setHostError(&host->error, "dlsym", "The libhostfxr.so doesn't export the hostfxr_initialize_for_runtime_config functions");
+      return COR_E_ENTRYPOINTNOTFOUND;
+  }
+
+  errorWriterScope errorWriter(host);
+  hostfxr_initialize_parameters parameters = { sizeof(hostfxr_initialize_parameters), hostPath, dotnetRoot };
+  hostfxr_initialize_for_runtime_config_fn initialize = (hostfxr_initialize_for_runtime_config_fn)host->initializeForRuntimeConfig;
+  int st = initialize(runtimeConfigPath, &parameters, &host->hostContext);
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "hostfxr_initialize_for_runtime_config", "hostfxr_initialize_for_runtime_config failed - status: 0x%08x", st);
+  }
+  return st;
+}
+
+int getHostFXRProperty(hostfxrHost* host, const char* name, const char** value) {
+  hostfxr_get_runtime_property_value_fn get = (hostfxr_get_runtime_property_value_fn)host->getRuntimePropertyValue;
+  return get(host->hostContext, name, value);
+}
+
+int setHostFXRProperty(hostfxrHost* host, const char* name, const char* value) {
+  hostfxr_set_runtime_property_value_fn set = (hostfxr_set_runtime_property_value_fn)host->setRuntimePropertyValue;
+  return set(host->hostContext, name, value);
+}
+
+// getHelperFunction resolves a helper entry point, from the default load context when the runtime
+// supports hdt_get_function_pointer (.NET 5+) and by loading the helper as a component otherwise.
+int getHelperFunction(hostfxrHost* host, const char* helperPath, const char* methodName, void** f) {
+  if (host->getFunctionPointer != nullptr) {
+    get_function_pointer_fn get = (get_function_pointer_fn)host->getFunctionPointer;
+    int st = get(helperTypeName, methodName, nullptr, nullptr, nullptr, f);
+    if (SUCCEEDED(st)) {
+      return st;
+    }
+  }
+  load_assembly_and_get_function_pointer_fn load = (load_assembly_and_get_function_pointer_fn)host->loadAssemblyAndGetFunctionPointer;
+  return load(helperPath, helperTypeName, methodName, nullptr, nullptr, f);
+}
+
+int loadHostFXRRuntime(hostfxrHost* host, const char* helperPath) {
+  errorWriterScope errorWriter(host);
+  hostfxr_get_runtime_delegate_fn getDelegate = (hostfxr_get_runtime_delegate_fn)host->getRuntimeDelegate;
+
+  // The first delegate request loads the runtime:
+  int st = getDelegate(host->hostContext, hdt_load_assembly_and_get_function_pointer, &host->loadAssemblyAndGetFunctionPointer);
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "hostfxr_get_runtime_delegate", "hostfxr_get_runtime_delegate failed - status: 0x%08x", st);
+    return st;
+  }
+  // Not available before .NET 5:
+  if (!SUCCEEDED(getDelegate(host->hostContext, hdt_get_function_pointer, &host->getFunctionPointer))) {
+    host->getFunctionPointer = nullptr;
+  }
+
+  st = getHelperFunction(host, helperPath, "CreateDelegate", &host->createDelegate);
+  if (SUCCEEDED(st)) {
+    st = getHelperFunction(host, helperPath, "ExecuteAssembly", &host->executeAssembly);
+  }
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "helper", "Loading the GoDotnet helper failed - status: 0x%08x", st);
+  }
+  return st;
+}
+
+int callHelperFunction(void* f, void* args, int size) {
+  component_entry_point_fn entryPoint = (component_entry_point_fn)f;
+  return entryPoint(args, size);
+}
+
+int closeHostFXR(hostfxrHost* host) {
+  hostfxr_close_fn close = (hostfxr_close_fn)host->close;
+  if (close == nullptr || host->hostContext == nullptr) {
+    return HOST_E_INVALIDOPERATION;
+  }
+  return close(host->hostContext);
+}
+
+int loadComponent(hostfxrHost* host, const char* assemblyPath, const char* typeName, const char* methodName, const char* delegateTypeName, int unmanagedCallersOnly, void** f) {
+  errorWriterScope errorWriter(host);
+  load_assembly_and_get_function_pointer_fn load = (load_assembly_and_get_function_pointer_fn)host->loadAssemblyAndGetFunctionPointer;
+  if (load == nullptr) {
+    return HOST_E_INVALIDOPERATION;
+  }
+  if (unmanagedCallersOnly) {
+    delegateTypeName = UNMANAGEDCALLERSONLY_METHOD;
+  }
+  return load(assemblyPath, typeName, methodName, delegateTypeName, nullptr, f);
+}
+
+int createDelegateHostFXR(hostfxrHost* host, const char* assemblyName, const char* typeName, const char* methodName, void** f) {
+  createDelegateArgs args = { assemblyName, typeName, methodName, f };
+  component_entry_point_fn createDelegate = (component_entry_point_fn)host->createDelegate;
+  if (createDelegate == nullptr) {
+    setHostError(&host->error, "lifecycle", "GoDotnet.Host.CreateDelegate called before the runtime was loaded");
+    return HOST_E_INVALIDOPERATION;
+  }
+  int st = createDelegate(&args, sizeof(args));
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "helper", "GoDotnet.Host.CreateDelegate failed - status: 0x%08x", st);
+  }
+  return st;
+}
+
+int executeAssemblyHostFXR(hostfxrHost* host, const char* assembly, int argc, const char** argv, unsigned int* exitCode) {
+  executeAssemblyArgs args = { assembly, argc, argv, exitCode };
+  component_entry_point_fn executeAssembly = (component_entry_point_fn)host->executeAssembly;
+  if (executeAssembly == nullptr) {
+    setHostError(&host->error, "lifecycle", "GoDotnet.Host.ExecuteAssembly called before the runtime was loaded");
+    return HOST_E_INVALIDOPERATION;
+  }
+  int st = executeAssembly(&args, sizeof(args));
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "helper", "GoDotnet.Host.ExecuteAssembly failed - status: 0x%08x", st);
+  }
+  return st;
+}
@@ -0,0 +1,349 @@
+package dotnet
+
+/*
+#include <stdlib.h>
+#include "hostfxr.hpp"
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// UnmanagedCallersOnly is passed as the delegate type to LoadComponent for methods
+// marked with [UnmanagedCallersOnly], available since .NET 5.
+const UnmanagedCallersOnly = "[UnmanagedCallersOnly]"
+
+// Status codes returned by hostfxr_initialize_for_runtime_config when the runtime is already loaded.
+const (
+	hostfxrHostAlreadyInitialized     = 1
+	hostfxrDifferentRuntimeProperties = 2
+)
+
+// ErrBackendNotSupported is returned by the calls that the selected Backend doesn't implement.
+var ErrBackendNotSupported = errors.New("Not supported by this hosting backend")
+
+// Backend selects how the runtime gets loaded.
+type Backend int
+
+const (
+	// BackendCoreCLR loads libcoreclr directly and calls coreclr_initialize,
+	// the package resolves frameworks, runtimeconfig.json and deps.json files by itself.
+	BackendCoreCLR Backend = iota
+	// BackendHostFXR loads the runtime through libhostfxr like the dotnet muxer does,
+	// hostfxr resolves the frameworks from a runtimeconfig.json file.
+	BackendHostFXR
+)
+
+// String returns the backend name.
+func (b Backend) String() string {
+	switch b {
+	case BackendCoreCLR:
+		return "coreclr"
+	case BackendHostFXR:
+		return "hostfxr"
+	}
+	return fmt.Sprintf("Backend(%d)", int(b))
+}
+
+// initializeHostFXR loads the runtime through hostfxr_initialize_for_runtime_config.
+// Without a RuntimeParams.RuntimeConfigPath, a runtimeconfig.json is generated for the framework
+// selected by FrameworkVersion and RollForward, like the coreclr backend does.
+func (r *Runtime) initializeHostFXR() (err error) {
+	var root string
+	configPath := r.Params.RuntimeConfigPath
+	if configPath == "" {
+		if _, err = r.resolveFrameworks([]frameworkReference{{Name: frameworkName}}); err == nil {
+			configPath, err = writeRuntimeConfig(filepath.Dir(r.helperPath), r.framework)
+		}
+		if err != nil {
+			r.log(LevelError, err.Error(), Fields{FieldStage: "resolve"})
+			return err
+		}
+		// Frameworks live in <root>/shared/<name>/<version>:
+		root = filepath.Dir(filepath.Dir(filepath.Dir(r.framework.Path)))
+	}
+	hostfxrPath, root, err := locateHostFXR(root)
+	if err != nil {
+		r.log(LevelError, err.Error(), Fields{FieldStage: "resolve"})
+		return err
+	}
+	r.log(LevelDebug, "Loading libhostfxr", Fields{FieldPath: hostfxrPath, "runtimeconfig": configPath})
+
+	cHostfxrPath := C.CString(hostfxrPath)
+	cConfigPath := C.CString(configPath)
+	cExePath := C.CString(r.Params.ExePath)
+	cRoot := C.CString(root)
+	result := r.hostfxrCall(func() C.int {
+		return C.initializeHostFXR(&r.fxr, cHostfxrPath, cConfigPath, cExePath, cRoot)
+	})
+	C.free(unsafe.Pointer(cHostfxrPath))
+	C.free(unsafe.Pointer(cConfigPath))
+	C.free(unsafe.Pointer(cExePath))
+	C.free(unsafe.Pointer(cRoot))
+	if result < 0 {
+		err = newHRESULTError(OpInitialize, int32(result))
+		r.logHostError(&r.fxr.error, err, Fields{FieldPath: hostfxrPath})
+		return err
+	}
+	if result == hostfxrHostAlreadyInitialized || result == hostfxrDifferentRuntimeProperties {
+		C.closeHostFXR(&r.fxr)
+		err = &HRESULTError{Op: OpInitialize, Code: hrHostInvalidOperation}
+		r.logHostError(&r.fxr.error, err, Fields{FieldPath: hostfxrPath})
+		return err
+	}
+
+	properties, err := r.hostfxrProperties()
+	if err == nil {
+		err = r.setHostFXRProperties(properties)
+	}
+	if err != nil {
+		r.log(LevelError, err.Error(), Fields{FieldStage: "resolve"})
+	} else {
+		cHelperPath := C.CString(r.helperPath)
+		result := r.hostfxrCall(func() C.int {
+			return C.loadHostFXRRuntime(&r.fxr, cHelperPath)
+		})
+		C.free(unsafe.Pointer(cHelperPath))
+		if result < 0 {
+			err = newHRESULTError(OpInitialize, int32(result))
+			r.logHostError(&r.fxr.error, err, Fields{FieldPath: r.helperPath})
+		}
+	}
+	if err != nil {
+		C.closeHostFXR(&r.fxr)
+	}
+	return err
+}
+
+var (
+	// hostfxrErrorsMu serializes the hostfxr calls that report errors through goHostFXRError.
+	// hostfxr_set_error_writer doesn't take a context, so the runtime of the caller is kept
+	// in hostfxrErrorsRuntime for the duration of the call.
+	hostfxrErrorsMu sync.Mutex
+	// hostfxrErrorsRuntime is read by goHostFXRError, which hostfxr may call from another thread.
+	hostfxrErrorsRuntime atomic.Pointer[Runtime]
+)
+
+// hostfxrCall runs a hostfxr call, the errors that hostfxr and hostpolicy report are logged.
+func (r *Runtime) hostfxrCall(call func() C.int) C.int {
+	hostfxrErrorsMu.Lock()
+	defer hostfxrErrorsMu.Unlock()
+	hostfxrErrorsRuntime.Store(r)
+	defer hostfxrErrorsRuntime.Store(nil)
+	return call()
+}
+
+//export goHostFXRError
+func goHostFXRError(message *C.char) {
+	// The errors reported outside of hostfxrCall aren't logged:
+	if r := hostfxrErrorsRuntime.Load(); r != nil {
+		r.log(LevelError, C.GoString(message), Fields{FieldStage: "hostfxr"})
+	}
+}
+
+// hostfxrProperties adds the application assets, the helper assembly and RuntimeParams.Properties
+// to the properties resolved by hostfxr, which only reads the deps.json files of the frameworks.
+func (r *Runtime) hostfxrProperties() (map[string]string, error) {
+	properties := make(map[string]string)
+	for k, v := range r.Params.Properties {
+		properties[k] = v
+	}
+
+	if fxDepsFile := r.hostfxrProperty(fxDepsFileProperty); fxDepsFile != "" {
+		r.framework = Framework{Name: frameworkName, Path: filepath.Dir(fxDepsFile)}
+		r.framework.Version, _ = ParseVersion(filepath.Base(r.framework.Path))
+	}
+
+	tpa, ok := properties[tpaProperty]
+	if !ok {
+		tpa = r.hostfxrProperty(tpaProperty)
+	}
+	assemblies := filepath.SplitList(tpa)
+	if appDir := r.appDir(); appDir != "" {
+		var probingPaths []string
+		if r.Params.RuntimeConfigPath != "" {
+			config, err := readRuntimeConfig(r.Params.RuntimeConfigPath)
+			if err != nil {
+				return nil, err
+			}
+			probingPaths = config.AdditionalProbingPaths
+		}
+		assets, err := r.appAssets(appDir, probingPaths)
+		if err != nil {
+			return nil, err
+		}
+		assemblies = mergeAssemblies(assemblies, assets.Assemblies)
+		if _, ok := properties[nativeSearchDirectoriesProperty]; !ok && len(assets.NativeDirectories) > 0 {
+			nativeDirs := append(filepath.SplitList(r.hostfxrProperty(nativeSearchDirectoriesProperty)), assets.NativeDirectories...)
+			properties[nativeSearchDirectoriesProperty] = strings.Join(nativeDirs, string(os.PathListSeparator))
+		}
+		if r.Params.DepsFilePath != "" {
+			properties[appContextDepsFilesProperty] = r.Params.DepsFilePath
+		}
+	}
+	assemblies = mergeAssemblies(assemblies, []string{r.helperPath})
+	properties[tpaProperty] = strings.Join(assemblies, string(os.PathListSeparator))
+	return properties, nil
+}
+
+// hostfxrProperty returns a runtime property of the host context, empty if it isn't set.
+func (r *Runtime) hostfxrProperty(name string) string {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	var value *C.char
+	if C.getHostFXRProperty(&r.fxr, cName, &value) < 0 {
+		return ""
+	}
+	return C.GoString(value)
+}
+
+// setHostFXRProperties sets the properties of the host context, before the runtime gets loaded.
+func (r *Runtime) setHostFXRProperties(properties map[string]string) error {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := C.CString(k)
+		value := C.CString(properties[k])
+		result := C.setHostFXRProperty(&r.fxr, name, value)
+		C.free(unsafe.Pointer(name))
+		C.free(unsafe.Pointer(value))
+		if result < 0 {
+			return newHRESULTError(OpInitialize, int32(result))
+		}
+	}
+	return nil
+}
+
+// LoadComponent loads an assembly into its own load context, the way native hosts load plugins
+// through hostfxr, and returns a function pointer to a public static method of typ, which must be
+// an assembly qualified type name. An empty delegateType expects the default component signature,
+// int ComponentEntryPoint(IntPtr args, int sizeBytes), UnmanagedCallersOnly expects a method marked
+// with [UnmanagedCallersOnly] and any other value is the assembly qualified name of a delegate type.
+// It's only supported by BackendHostFXR.
+func (r *Runtime) LoadComponent(assemblyPath, typ, method, delegateType string) (unsafe.Pointer, error) {
+	release, err := r.checkRunning(OpLoadComponent)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if r.Params.Backend != BackendHostFXR {
+		return nil, ErrBackendNotSupported
+	}
+	cAssemblyPath := C.CString(assemblyPath)
+	cTyp := C.CString(typ)
+	cMethod := C.CString(method)
+	defer C.free(unsafe.Pointer(cAssemblyPath))
+	defer C.free(unsafe.Pointer(cTyp))
+	defer C.free(unsafe.Pointer(cMethod))
+
+	var cDelegateType *C.char
+	unmanagedCallersOnly := C.int(0)
+	switch delegateType {
+	case "":
+	case UnmanagedCallersOnly:
+		unmanagedCallersOnly = 1
+	default:
+		cDelegateType = C.CString(delegateType)
+		defer C.free(unsafe.Pointer(cDelegateType))
+	}
+
+	var f unsafe.Pointer
+	result := r.hostfxrCall(func() C.int {
+		return C.loadComponent(&r.fxr, cAssemblyPath, cTyp, cMethod, cDelegateType, unmanagedCallersOnly, &f)
+	})
+	if result < 0 {
+		err := newHRESULTError(OpLoadComponent, int32(result))
+		r.logHostError(&r.fxr.error, err, Fields{FieldPath: assemblyPath, "type": typ, "method": method})
+		return nil, err
+	}
+	return f, nil
+}
+
+// locateHostFXR finds the newest libhostfxr of an install location, like nethost's get_hostfxr_path.
+// An empty root searches the same locations as locateFrameworks.
+func locateHostFXR(root string) (path, dotnetRoot string, err error) {
+	roots := []string{root}
+	if root == "" {
+		roots = dotnetRoots()
+	}
+	for _, root := range roots {
+		var versions []Version
+		entries, _ := ioutil.ReadDir(filepath.Join(root, "host", "fxr"))
+		for _, entry := range entries {
+			if v, err := ParseVersion(entry.Name()); err == nil && entry.IsDir() {
+				versions = append(versions, v)
+			}
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Compare(versions[j]) > 0 })
+		for _, v := range versions {
+			path := filepath.Join(root, "host", "fxr", v.String(), hostfxrLibrary())
+			if _, err := os.Stat(path); err == nil {
+				return path, root, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("%w: %s", ErrFrameworkNotFound, hostfxrLibrary())
+}
+
+// hostfxrLibrary returns the file name of the hostfxr library for the current platform.
+func hostfxrLibrary() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "libhostfxr.dylib"
+	case "windows":
+		return "hostfxr.dll"
+	}
+	return "libhostfxr.so"
+}
+
+// writeRuntimeConfig generates a runtimeconfig.json file that pins the given framework.
+func writeRuntimeConfig(dir string, f Framework) (string, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"runtimeOptions": map[string]interface{}{
+			"rollForward": RollForwardDisable.String(),
+			"framework":   map[string]string{"name": f.Name, "version": f.Version.String()},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "go-dotnet-"+f.Version.String()+".runtimeconfig.json")
+	return path, writeFileAtomic(path, data)
+}
+
+// writeFileAtomic writes a file through a temporary file, so that concurrent processes never see it half written.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
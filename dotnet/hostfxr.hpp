@@ -0,0 +1,60 @@
+#pragma once
+
+#include <stdint.h>
+
+#include "runtime.hpp"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// hostfxrHost holds the state of a runtime loaded through libhostfxr: the library, the host context
+// and the delegates resolved from it, including the GoDotnet helper entry points.
+// Function pointers are kept as void* so that this struct is visible from cgo.
+typedef struct hostfxrHost {
+  void* hostfxrLib;
+  void* hostContext;
+
+  void* initializeForRuntimeConfig;
+  void* getRuntimeDelegate;
+  void* getRuntimePropertyValue;
+  void* setRuntimePropertyValue;
+  void* close;
+  void* setErrorWriter;
+
+  void* loadAssemblyAndGetFunctionPointer;
+  void* getFunctionPointer;
+
+  void* createDelegate;
+  void* executeAssembly;
+
+  hostError error;
+} hostfxrHost;
+
+int initializeHostFXR(hostfxrHost* host,
+            const char* hostfxrPath,
+            const char* runtimeConfigPath,
+            const char* hostPath,
+            const char* dotnetRoot);
+int getHostFXRProperty(hostfxrHost* host, const char* name, const char** value);
+int setHostFXRProperty(hostfxrHost* host, const char* name, const char* value);
+int loadHostFXRRuntime(hostfxrHost* host, const char* helperPath);
+int closeHostFXR(hostfxrHost* host);
+
+// Helper entry points follow the default component entry point signature, int (void* args, int size):
+int getHelperFunction(hostfxrHost* host, const char* helperPath, const char* methodName, void** f);
+int callHelperFunction(void* f, void* args, int size);
+
+// setConsoleArgs is passed to GoDotnet.Host.SetConsole:
+typedef int (*consoleWriteFn)(uintptr_t context, int stream, char* data, int length);
+typedef struct setConsoleArgs {
+  consoleWriteFn write;
+  uintptr_t context;
+} setConsoleArgs;
+
+int loadComponent(hostfxrHost* host, const char* assemblyPath, const char* typeName, const char* methodName, const char* delegateTypeName, int unmanagedCallersOnly, void** f);
+int createDelegateHostFXR(hostfxrHost* host, const char* assemblyName, const char* typeName, const char* methodName, void** f);
+int executeAssemblyHostFXR(hostfxrHost* host, const char* assembly, int argc, const char** argv, unsigned int* exitCode);
+#ifdef __cplusplus
+}
+#endif
@@ -0,0 +1,160 @@
+// Package testhelper holds the C glue used by the dotnet tests to call the raw function
+// pointers returned by CreateDelegate, test files can't use cgo.
+package testhelper
+
+/*
+#include <stdlib.h>
+
+#ifdef __GLIBC__
+#include <malloc.h>
+#endif
+
+// heapInUse returns the bytes allocated with malloc, or -1 when it's unknown.
+// glibc only counts the main arena, set MALLOC_ARENA_MAX=1 to count every thread.
+long long heapInUse() {
+#if defined(__GLIBC__) && __GLIBC_PREREQ(2, 33)
+	return (long long)mallinfo2().uordblks;
+#else
+	return -1;
+#endif
+}
+
+typedef int (*AddFunc)(int, int);
+AddFunc addFunc;
+
+void** getAddFunc() {
+	return (void**)&addFunc;
+}
+
+int callAddFunc(int a, int b) {
+	return addFunc(a, b);
+}
+
+typedef void (*DummyFunc)();
+DummyFunc dummyFunc;
+
+void** getDummyFunc() {
+	return (void**)&dummyFunc;
+}
+
+typedef char* (*StringFunc)();
+StringFunc stringFunc;
+
+void** getStringFunc() {
+	return (void**)&stringFunc;
+}
+
+char* callStringFunc() {
+	return stringFunc();
+}
+
+typedef char* (*GetDataFunc)(char*);
+GetDataFunc getDataFunc;
+
+void** getGetDataFunc() {
+	return (void**)&getDataFunc;
+}
+
+char* callGetDataFunc(char* name) {
+	return getDataFunc(name);
+}
+
+typedef void (*PrintFunc)(char*);
+PrintFunc printFunc;
+
+void** getPrintFunc() {
+	return (void**)&printFunc;
+}
+
+void callPrintFunc(char* message) {
+	printFunc(message);
+}
+
+typedef void (*SetExitCodeFunc)(int);
+SetExitCodeFunc setExitCodeFunc;
+
+void** getSetExitCodeFunc() {
+	return (void**)&setExitCodeFunc;
+}
+
+void callSetExitCodeFunc(int exitCode) {
+	setExitCodeFunc(exitCode);
+}
+
+typedef int (*ComponentFunc)(void*, int);
+
+int callComponentFunc(void* f, int size) {
+	return ((ComponentFunc)f)(NULL, size);
+}
+*/
+import "C"
+import "unsafe"
+
+// HeapInUse returns the bytes allocated with malloc, or -1 when glibc can't tell.
+func HeapInUse() int64 {
+	return int64(C.heapInUse())
+}
+
+// GetAddFunc returns the target of the int Add(int, int) delegate.
+func GetAddFunc() *unsafe.Pointer {
+	return C.getAddFunc()
+}
+
+func CallAddFunc(a, b int) int {
+	return int(C.callAddFunc(C.int(a), C.int(b)))
+}
+
+// GetDummyFunc returns a target that no test calls.
+func GetDummyFunc() *unsafe.Pointer {
+	return C.getDummyFunc()
+}
+
+// GetStringFunc returns the target of the string String() delegate.
+func GetStringFunc() *unsafe.Pointer {
+	return C.getStringFunc()
+}
+
+func CallStringFunc() string {
+	// The marshaled string belongs to the caller:
+	s := C.callStringFunc()
+	defer C.free(unsafe.Pointer(s))
+	return C.GoString(s)
+}
+
+// GetGetDataFunc returns the target of the string GetData(string) delegate.
+func GetGetDataFunc() *unsafe.Pointer {
+	return C.getGetDataFunc()
+}
+
+func CallGetDataFunc(name string) string {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	data := C.callGetDataFunc(cName)
+	defer C.free(unsafe.Pointer(data))
+	return C.GoString(data)
+}
+
+// GetPrintFunc returns the target of the void Print(string) delegate.
+func GetPrintFunc() *unsafe.Pointer {
+	return C.getPrintFunc()
+}
+
+func CallPrintFunc(message string) {
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	C.callPrintFunc(cMessage)
+}
+
+// GetSetExitCodeFunc returns the target of the void SetExitCode(int) delegate.
+func GetSetExitCodeFunc() *unsafe.Pointer {
+	return C.getSetExitCodeFunc()
+}
+
+func CallSetExitCodeFunc(exitCode int) {
+	C.callSetExitCodeFunc(C.int(exitCode))
+}
+
+// CallComponentFunc calls a component entry point, int (IntPtr args, int sizeBytes), with no arguments.
+func CallComponentFunc(f unsafe.Pointer, size int) int {
+	return int(C.callComponentFunc(f, C.int(size)))
+}
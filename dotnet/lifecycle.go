@@ -0,0 +1,84 @@
+package dotnet
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// State is the lifecycle state of a Runtime.
+type State int32
+
+// Runtime states, a runtime moves through them in order and can't be restarted once stopped.
+const (
+	StateUninitialized State = iota
+	StateStarting
+	StateRunning
+	StateShuttingDown
+	StateStopped
+)
+
+var stateNames = map[State]string{
+	StateUninitialized: "uninitialized",
+	StateStarting:      "starting",
+	StateRunning:       "running",
+	StateShuttingDown:  "shutting down",
+	StateStopped:       "stopped",
+}
+
+// String returns the state name.
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", int32(s))
+}
+
+// ErrInvalidState matches every StateError.
+var ErrInvalidState = errors.New("Invalid runtime state")
+
+// StateError is returned when a Runtime method is called in a state that doesn't allow it,
+// like CreateDelegate before the initialization or after Shutdown.
+type StateError struct {
+	// Op is the rejected operation.
+	Op Operation
+	// State is the state of the runtime when the operation was called.
+	State State
+}
+
+// Error implements the error interface.
+func (e *StateError) Error() string {
+	return fmt.Sprintf("%s failed: the runtime is %s", e.Op, e.State)
+}
+
+// Is reports whether target is ErrInvalidState.
+func (e *StateError) Is(target error) bool {
+	return target == ErrInvalidState
+}
+
+// runtimeLoaded is set once a runtime was initialized in this process, CoreCLR can't be loaded twice.
+var runtimeLoaded int32
+
+// State returns the lifecycle state of the runtime.
+func (r *Runtime) State() State {
+	return State(atomic.LoadInt32((*int32)(&r.state)))
+}
+
+// setState changes the lifecycle state, r.mu must be held for writing.
+func (r *Runtime) setState(s State) {
+	atomic.StoreInt32((*int32)(&r.state), int32(s))
+}
+
+// checkRunning returns a StateError unless the runtime is running. Otherwise the runtime isn't torn down until
+// release is called, once the native call completes. Shutdown waits for the release instead of holding r.mu
+// so that the calls made by managed code during the call, through a Callback, return a StateError meanwhile
+// instead of waiting for Shutdown.
+func (r *Runtime) checkRunning(op Operation) (release func(), err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if s := r.State(); s != StateRunning {
+		return nil, &StateError{Op: op, State: s}
+	}
+	r.calls.Add(1)
+	return r.calls.Done, nil
+}
@@ -0,0 +1,103 @@
+package dotnet
+
+/*
+#include "runtime.hpp"
+*/
+import "C"
+
+import (
+	"fmt"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+// Log levels, from the most verbose.
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+// String returns the level name.
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// Fields holds the structured values of a log entry.
+// The host diagnostics use the FieldPath, FieldHRESULT and FieldStage keys.
+type Fields map[string]interface{}
+
+// Field keys used by the host diagnostics.
+const (
+	// FieldPath is the file the entry refers to, like the runtime library or an assembly.
+	FieldPath = "path"
+	// FieldHRESULT is the failed HRESULT, formatted as 0x%08x.
+	FieldHRESULT = "hresult"
+	// FieldStage is the hosting step, like dlopen, dlsym or coreclr_initialize.
+	FieldStage = "stage"
+	// FieldBackend is the hosting backend, see Backend.
+	FieldBackend = "backend"
+)
+
+// Logger receives the host diagnostics of a runtime, set it with RuntimeParams.Logger.
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	Log(level Level, msg string, fields Fields)
+}
+
+// LoggerFunc adapts a function to the Logger interface.
+type LoggerFunc func(level Level, msg string, fields Fields)
+
+// Log calls f.
+func (f LoggerFunc) Log(level Level, msg string, fields Fields) {
+	f(level, msg, fields)
+}
+
+// log sends an entry to the configured logger, the backend is added to the fields.
+func (r *Runtime) log(level Level, msg string, fields Fields) {
+	if r.Params.Logger == nil {
+		return
+	}
+	if fields == nil {
+		fields = Fields{}
+	}
+	fields[FieldBackend] = r.Params.Backend.String()
+	r.Params.Logger.Log(level, msg, fields)
+}
+
+// hostError returns the error recorded by the C side of the selected backend.
+func (r *Runtime) hostError() *C.hostError {
+	if r.Params.Backend == BackendHostFXR {
+		return &r.fxr.error
+	}
+	return &r.host.error
+}
+
+// logHostError logs a failed hosting call, including the stage and message recorded by the C side, if any.
+func (r *Runtime) logHostError(hostErr *C.hostError, err error, fields Fields) {
+	if fields == nil {
+		fields = Fields{}
+	}
+	msg := err.Error()
+	if hostErr.stage != nil {
+		fields[FieldStage] = C.GoString(hostErr.stage)
+		msg = C.GoString(&hostErr.message[0])
+		hostErr.stage = nil
+	}
+	if hresultErr, ok := err.(*HRESULTError); ok {
+		fields[FieldHRESULT] = fmt.Sprintf("0x%08x", hresultErr.Code)
+	}
+	r.log(LevelError, msg, fields)
+}
@@ -0,0 +1,40 @@
+// Package logrusadapter sends the dotnet host diagnostics to logrus.
+package logrusadapter
+
+import (
+	"github.com/matiasinsaurralde/go-dotnet/dotnet"
+	"github.com/sirupsen/logrus"
+)
+
+// FieldLogger is implemented by *logrus.Logger and *logrus.Entry.
+type FieldLogger interface {
+	WithFields(fields logrus.Fields) *logrus.Entry
+}
+
+type adapter struct {
+	logger FieldLogger
+}
+
+// New returns a dotnet.Logger that writes to the given logrus logger or entry:
+//
+//	runtime, err := dotnet.NewRuntime(dotnet.RuntimeParams{
+//		Logger: logrusadapter.New(logrus.StandardLogger()),
+//	})
+func New(logger FieldLogger) dotnet.Logger {
+	return &adapter{logger: logger}
+}
+
+// Log implements dotnet.Logger.
+func (a *adapter) Log(level dotnet.Level, msg string, fields dotnet.Fields) {
+	entry := a.logger.WithFields(logrus.Fields(fields))
+	switch level {
+	case dotnet.LevelDebug:
+		entry.Debug(msg)
+	case dotnet.LevelInfo:
+		entry.Info(msg)
+	case dotnet.LevelWarn:
+		entry.Warn(msg)
+	default:
+		entry.Error(msg)
+	}
+}
@@ -0,0 +1,43 @@
+package logrusadapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/matiasinsaurralde/go-dotnet/dotnet"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.Out = &buf
+	logger.Formatter = &logrus.JSONFormatter{}
+	logger.Level = logrus.InfoLevel
+
+	l := New(logger)
+	l.Log(dotnet.LevelDebug, "hidden", nil)
+	l.Log(dotnet.LevelError, "dlopen failed", dotnet.Fields{
+		dotnet.FieldPath:    "/usr/share/dotnet/libcoreclr.so",
+		dotnet.FieldHRESULT: "0x80131524",
+		dotnet.FieldStage:   "dlopen",
+	})
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected a single JSON entry, got %q: %s", buf.String(), err)
+	}
+	expected := map[string]interface{}{
+		"level":   "error",
+		"msg":     "dlopen failed",
+		"path":    "/usr/share/dotnet/libcoreclr.so",
+		"hresult": "0x80131524",
+		"stage":   "dlopen",
+	}
+	for k, v := range expected {
+		if entry[k] != v {
+			t.Errorf("%s is %v, expected %v", k, entry[k], v)
+		}
+	}
+}
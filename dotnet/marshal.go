@@ -0,0 +1,93 @@
+package dotnet
+
+/*
+#include <stdlib.h>
+#include <stdint.h>
+
+static size_t utf16Length(const uint16_t* s) {
+  size_t n = 0;
+  while (s[n] != 0) {
+    n++;
+  }
+  return n;
+}
+*/
+import "C"
+
+import (
+	"unicode/utf16"
+	"unsafe"
+)
+
+// UTF16String is a string marshaled as a NUL terminated UTF-16 string, like LPWStr.
+// Use it in the functions passed to Bind and NewCallback, string parameters and results use UTF-8.
+type UTF16String string
+
+// StringToUTF8 returns a NUL terminated UTF-8 copy of s, the LPUTF8Str format. The memory is allocated
+// like Marshal.AllocCoTaskMem does (malloc on Linux and macOS) and must be released with FreeCoTaskMem,
+// unless it's handed to managed code that releases it.
+func StringToUTF8(s string) unsafe.Pointer {
+	return unsafe.Pointer(C.CString(s))
+}
+
+// StringToUTF16 returns a NUL terminated UTF-16 copy of s, the LPWStr format.
+// It's allocated like StringToUTF8.
+func StringToUTF16(s string) unsafe.Pointer {
+	u := utf16.Encode([]rune(s))
+	p := C.malloc(C.size_t(len(u)+1) * 2)
+	buf := (*[1 << 28]uint16)(p)[: len(u)+1 : len(u)+1]
+	copy(buf, u)
+	buf[len(u)] = 0
+	return p
+}
+
+// UTF8ToString copies a NUL terminated UTF-8 string, nil returns an empty string.
+// The memory isn't released, see FreeCoTaskMem.
+func UTF8ToString(p unsafe.Pointer) string {
+	if p == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(p))
+}
+
+// UTF16ToString copies a NUL terminated UTF-16 string, nil returns an empty string.
+// The memory isn't released, see FreeCoTaskMem.
+func UTF16ToString(p unsafe.Pointer) string {
+	if p == nil {
+		return ""
+	}
+	n := int(C.utf16Length((*C.uint16_t)(p)))
+	return string(utf16.Decode((*[1 << 28]uint16)(p)[:n:n]))
+}
+
+// FreeCoTaskMem releases memory allocated by StringToUTF8, StringToUTF16 or by managed code with
+// Marshal.AllocCoTaskMem, like the strings returned by managed methods. It's Marshal.FreeCoTaskMem,
+// free on Linux and macOS.
+func FreeCoTaskMem(p unsafe.Pointer) {
+	C.free(p)
+}
+
+func (k kind) isString() bool {
+	return k == kindString || k == kindUTF16String
+}
+
+// newString allocates the native copy of a string parameter, owned by the caller.
+func (k kind) newString(s string) unsafe.Pointer {
+	if k == kindUTF16String {
+		return StringToUTF16(s)
+	}
+	return StringToUTF8(s)
+}
+
+// goString copies a native string without releasing it.
+func (k kind) goString(p unsafe.Pointer) string {
+	if k == kindUTF16String {
+		return UTF16ToString(p)
+	}
+	return UTF8ToString(p)
+}
+
+// rawPointer converts a raw delegate value to a pointer.
+func rawPointer(r uint64) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&r))
+}
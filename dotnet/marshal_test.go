@@ -0,0 +1,119 @@
+package dotnet
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/matiasinsaurralde/go-dotnet/dotnet/internal/testhelper"
+)
+
+func TestStringConversions(t *testing.T) {
+	for _, s := range []string{"", "teststring", "ñandú", "𝄞 clef"} {
+		p := StringToUTF8(s)
+		if got := UTF8ToString(p); got != s {
+			t.Fatalf("UTF-8: got %q, expected %q", got, s)
+		}
+		FreeCoTaskMem(p)
+		p = StringToUTF16(s)
+		if got := UTF16ToString(p); got != s {
+			t.Fatalf("UTF-16: got %q, expected %q", got, s)
+		}
+		FreeCoTaskMem(p)
+	}
+	if UTF8ToString(nil) != "" || UTF16ToString(nil) != "" {
+		t.Fatal("Expected empty strings")
+	}
+}
+
+func TestStringDelegates(t *testing.T) {
+	greet, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Greet", "string(string)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, err := greet.Call("ñandú"); err != nil || result != "Hello ñandú" {
+		t.Fatalf("Got %q, %v", result, err)
+	}
+	shout, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Shout", "utf16string(utf16string)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, err := shout.Call(UTF16String("ñandú 𝄞")); err != nil || result != "ÑANDÚ 𝄞!" {
+		t.Fatalf("Got %q, %v", result, err)
+	}
+	if _, err := shout.Call(1); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	greetFunc, err := Bind[func(string) string](testRuntime, "Test", "Test.TestClass", "Greet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := greetFunc("Rob"); result != "Hello Rob" {
+		t.Fatalf("Got %q", result)
+	}
+	shoutFunc, err := Bind[func(UTF16String) UTF16String](testRuntime, "Test", "Test.TestClass", "Shout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := shoutFunc("go"); result != "GO!" {
+		t.Fatalf("Got %q", result)
+	}
+
+	greeter, err := testRuntime.NewCallback(func(name string) string {
+		return "Hi " + name
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer greeter.Close()
+	applyGreeter, err := Bind[func(unsafe.Pointer, string) string](testRuntime, "Test", "Test.TestClass", "ApplyGreeter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := applyGreeter(greeter.Pointer(), "ñandú"); result != "Hi ñandú?" {
+		t.Fatalf("Got %q", result)
+	}
+}
+
+// testArenaVariable is set by TestStringLeaks, testhelper.HeapInUse only counts every thread with a single malloc arena.
+const testArenaVariable = "MALLOC_ARENA_MAX"
+
+func TestStringLeaks(t *testing.T) {
+	if testhelper.HeapInUse() < 0 {
+		t.Skip("HeapInUse isn't supported")
+	}
+	if os.Getenv(testArenaVariable) != "1" {
+		output := runTestProcess(t, testArenaVariable, "1", "^TestStringLeaks$")
+		if !strings.Contains(output, "--- PASS: TestStringLeaks") {
+			t.Fatalf("Leak test didn't run:\n%s", output)
+		}
+		return
+	}
+	greet, err := Bind[func(string) string](testRuntime, "Test", "Test.TestClass", "Greet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shout, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Shout", "utf16string(utf16string)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := func() {
+		testhelper.CallStringFunc()
+		greet(strings.Repeat("x", 100))
+		shout.Call(strings.Repeat("y", 100))
+	}
+	const calls = 20000
+	for i := 0; i < calls/10; i++ {
+		run()
+	}
+	before := testhelper.HeapInUse()
+	for i := 0; i < calls; i++ {
+		run()
+	}
+	// A leak would be at least a few hundred bytes per call:
+	if growth := testhelper.HeapInUse() - before; growth > calls {
+		t.Fatalf("The C heap grew %d bytes after %d calls", growth, calls)
+	}
+}
@@ -0,0 +1,237 @@
+package dotnet
+
+import (
+	"bufio"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// latencyBuckets are the upper bounds of the call latency histograms, in seconds.
+var latencyBuckets = []float64{1e-6, 5e-6, 1e-5, 5e-5, 1e-4, 5e-4, 1e-3, 5e-3, 1e-2, 5e-2, 0.1, 0.5, 1, 5}
+
+// Metrics collects the calls of the delegates of a runtime and a few runtime gauges, it's enabled by
+// RuntimeParams.Metrics. Every Delegate call, through Call or a function created by Bind or BindPlugin,
+// is counted for its method, with its latency and whether it returned an error. The raw function pointers
+// returned by CreateDelegate are only counted as bound methods, their calls don't go through the package.
+//
+// Metrics is an http.Handler that renders the Prometheus text format, Publish exposes the same data with expvar.
+type Metrics struct {
+	start time.Time
+
+	liveObjects int64
+
+	// mu guards the stats by method and the number of delegates bound to each method.
+	mu      sync.Mutex
+	methods map[MethodKey]*methodStats
+	bound   map[MethodKey]int
+}
+
+// MethodKey identifies a bound method.
+type MethodKey struct {
+	Assembly string `json:"assembly"`
+	Type     string `json:"type"`
+	Method   string `json:"method"`
+}
+
+// MetricsSnapshot holds the metrics values at a point in time.
+type MetricsSnapshot struct {
+	UptimeSeconds float64 `json:"uptime_seconds"`
+	// BoundDelegates is the number of methods bound to delegates, by CreateDelegate, NewDelegate, Bind or a Plugin.
+	// A method bound more than once is counted once, the plugin methods are removed by Unload and the
+	// methods are reset by Shutdown.
+	BoundDelegates int64           `json:"bound_delegates"`
+	LiveObjects    int64           `json:"live_objects"`
+	Methods        []MethodMetrics `json:"methods"`
+}
+
+// MethodMetrics holds the call metrics of a method.
+type MethodMetrics struct {
+	MethodKey
+	Calls             uint64  `json:"calls"`
+	Errors            uint64  `json:"errors"`
+	LatencySumSeconds float64 `json:"latency_sum_seconds"`
+	// LatencyBuckets are cumulative, the calls slower than the last bound are only counted in Calls.
+	LatencyBuckets []LatencyBucket `json:"latency_buckets"`
+}
+
+// LatencyBucket is the number of calls that took up to UpperBound seconds.
+type LatencyBucket struct {
+	UpperBound float64 `json:"le"`
+	Count      uint64  `json:"count"`
+}
+
+// methodStats are updated atomically by the delegate calls.
+type methodStats struct {
+	calls, errors uint64
+	sumNanos      uint64
+	// buckets counts the calls by latency bucket, the last one is +Inf.
+	buckets []uint64
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{start: time.Now(), methods: make(map[MethodKey]*methodStats), bound: make(map[MethodKey]int)}
+}
+
+// Metrics returns the runtime metrics, or nil when RuntimeParams.Metrics isn't set.
+func (r *Runtime) Metrics() *Metrics {
+	return r.metrics
+}
+
+// method returns the stats of a method, the delegates bound to it share them.
+func (m *Metrics) method(key MethodKey) *methodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.methods[key]
+	if !ok {
+		s = &methodStats{buckets: make([]uint64, len(latencyBuckets)+1)}
+		m.methods[key] = s
+	}
+	return s
+}
+
+// bind counts a delegate bound to a method.
+func (m *Metrics) bind(key MethodKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bound[key]++
+}
+
+// release removes a delegate bound to a method, once its plugin is unloaded.
+func (m *Metrics) release(key MethodKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.bound[key]--; m.bound[key] <= 0 {
+		delete(m.bound, key)
+	}
+}
+
+// releaseAll removes the bound delegates, it's called by Shutdown.
+func (m *Metrics) releaseAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bound = make(map[MethodKey]int)
+}
+
+func (m *Metrics) addLiveObjects(n int) {
+	atomic.AddInt64(&m.liveObjects, int64(n))
+}
+
+func (s *methodStats) observe(latency time.Duration, failed bool) {
+	atomic.AddUint64(&s.calls, 1)
+	if failed {
+		atomic.AddUint64(&s.errors, 1)
+	}
+	atomic.AddUint64(&s.sumNanos, uint64(latency))
+	seconds := latency.Seconds()
+	i := sort.SearchFloat64s(latencyBuckets, seconds)
+	atomic.AddUint64(&s.buckets[i], 1)
+}
+
+// Snapshot returns the current values, the methods are sorted by assembly, type and method.
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		UptimeSeconds: time.Since(m.start).Seconds(),
+		LiveObjects:   atomic.LoadInt64(&m.liveObjects),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot.BoundDelegates = int64(len(m.bound))
+	for key, s := range m.methods {
+		method := MethodMetrics{
+			MethodKey:         key,
+			Calls:             atomic.LoadUint64(&s.calls),
+			Errors:            atomic.LoadUint64(&s.errors),
+			LatencySumSeconds: time.Duration(atomic.LoadUint64(&s.sumNanos)).Seconds(),
+		}
+		var count uint64
+		for i, bound := range latencyBuckets {
+			count += atomic.LoadUint64(&s.buckets[i])
+			method.LatencyBuckets = append(method.LatencyBuckets, LatencyBucket{UpperBound: bound, Count: count})
+		}
+		snapshot.Methods = append(snapshot.Methods, method)
+	}
+	sort.Slice(snapshot.Methods, func(i, j int) bool {
+		a, b := snapshot.Methods[i].MethodKey, snapshot.Methods[j].MethodKey
+		if a.Assembly != b.Assembly {
+			return a.Assembly < b.Assembly
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Method < b.Method
+	})
+	return snapshot
+}
+
+// Publish exposes the snapshots with expvar under name, like expvar.Publish it panics if the name is already used.
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return m.Snapshot()
+	}))
+}
+
+// ServeHTTP renders the metrics in the Prometheus text exposition format:
+//
+//	http.Handle("/metrics", runtime.Metrics())
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WritePrometheus(w)
+}
+
+// WritePrometheus writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	s := m.Snapshot()
+	b := bufio.NewWriter(w)
+	metric := func(name, kind, help string) {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+	metric("dotnet_uptime_seconds", "gauge", "Time since the runtime started.")
+	fmt.Fprintf(b, "dotnet_uptime_seconds %s\n", formatFloat(s.UptimeSeconds))
+	metric("dotnet_bound_delegates", "gauge", "Number of methods bound to delegates.")
+	fmt.Fprintf(b, "dotnet_bound_delegates %d\n", s.BoundDelegates)
+	metric("dotnet_live_objects", "gauge", "Number of managed objects held by Object handles.")
+	fmt.Fprintf(b, "dotnet_live_objects %d\n", s.LiveObjects)
+
+	metric("dotnet_delegate_calls_total", "counter", "Number of delegate calls.")
+	for _, method := range s.Methods {
+		fmt.Fprintf(b, "dotnet_delegate_calls_total{%s} %d\n", method.labels(), method.Calls)
+	}
+	metric("dotnet_delegate_errors_total", "counter", "Number of delegate calls that returned an error.")
+	for _, method := range s.Methods {
+		fmt.Fprintf(b, "dotnet_delegate_errors_total{%s} %d\n", method.labels(), method.Errors)
+	}
+	metric("dotnet_delegate_call_duration_seconds", "histogram", "Latency of the delegate calls.")
+	for _, method := range s.Methods {
+		labels := method.labels()
+		for _, bucket := range method.LatencyBuckets {
+			fmt.Fprintf(b, "dotnet_delegate_call_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels, formatFloat(bucket.UpperBound), bucket.Count)
+		}
+		fmt.Fprintf(b, "dotnet_delegate_call_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, method.Calls)
+		fmt.Fprintf(b, "dotnet_delegate_call_duration_seconds_sum{%s} %s\n", labels, formatFloat(method.LatencySumSeconds))
+		fmt.Fprintf(b, "dotnet_delegate_call_duration_seconds_count{%s} %d\n", labels, method.Calls)
+	}
+	return b.Flush()
+}
+
+func (m MethodMetrics) labels() string {
+	return fmt.Sprintf("assembly=\"%s\",type=\"%s\",method=\"%s\"", escapeLabel(m.Assembly), escapeLabel(m.Type), escapeLabel(m.Method))
+}
+
+// labelEscaper escapes the label values of the Prometheus text format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,139 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testMetricsVariable enables the metrics of the test runtime, see TestMetrics.
+const testMetricsVariable = "GO_DOTNET_TEST_METRICS"
+
+func TestMetrics(t *testing.T) {
+	if os.Getenv(testMetricsVariable) == "" {
+		if testRuntime.Metrics() != nil {
+			t.Fatal("The metrics are enabled")
+		}
+		output := runTestProcess(t, testMetricsVariable, "1", "^TestMetrics$")
+		if !strings.Contains(output, "--- PASS: TestMetrics") {
+			t.Fatalf("Metrics test didn't run:\n%s", output)
+		}
+		return
+	}
+	m := testRuntime.Metrics()
+	bound := m.Snapshot().BoundDelegates
+	if bound != 4 {
+		t.Fatalf("Got %d bound delegates after init", bound)
+	}
+
+	add, err := Bind[func(int32, int32) int32](testRuntime, "Test", "Test.TestClass", "Add")
+	if err != nil {
+		t.Fatal(err)
+	}
+	divide, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Divide", "int32(int32,int32)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		add(1, 2)
+	}
+	divide.Call(4, 2)
+	if _, err := divide.Call(1, 0); !errors.Is(err, ErrManagedException) {
+		t.Fatalf("Got %v", err)
+	}
+	counter, err := testRuntime.New("Test", "Test.Counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer counter.Close()
+	closed, err := testRuntime.New("Test", "Test.Counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed.Close()
+
+	s := m.Snapshot()
+	// Add was bound by the test runtime already:
+	if s.BoundDelegates != bound+1 || s.LiveObjects != 1 || s.UptimeSeconds <= 0 || len(s.Methods) != 2 {
+		t.Fatalf("Got %+v", s)
+	}
+	addMetrics, divideMetrics := s.Methods[0], s.Methods[1]
+	if addMetrics.MethodKey != (MethodKey{"Test", "Test.TestClass", "Add"}) || addMetrics.Calls != 3 || addMetrics.Errors != 0 ||
+		addMetrics.LatencySumSeconds <= 0 || len(addMetrics.LatencyBuckets) != len(latencyBuckets) {
+		t.Fatalf("Got %+v", addMetrics)
+	}
+	if last := addMetrics.LatencyBuckets[len(latencyBuckets)-1]; last.UpperBound != 5 || last.Count != 3 {
+		t.Fatalf("Got %+v", last)
+	}
+	if divideMetrics.Method != "Divide" || divideMetrics.Calls != 2 || divideMetrics.Errors != 1 {
+		t.Fatalf("Got %+v", divideMetrics)
+	}
+
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body := recorder.Body.String()
+	for _, line := range []string{
+		"# TYPE dotnet_uptime_seconds gauge\n",
+		"dotnet_bound_delegates 5\n",
+		"dotnet_live_objects 1\n",
+		"# TYPE dotnet_delegate_calls_total counter\n",
+		`dotnet_delegate_calls_total{assembly="Test",type="Test.TestClass",method="Add"} 3` + "\n",
+		`dotnet_delegate_errors_total{assembly="Test",type="Test.TestClass",method="Divide"} 1` + "\n",
+		"# TYPE dotnet_delegate_call_duration_seconds histogram\n",
+		`dotnet_delegate_call_duration_seconds_bucket{assembly="Test",type="Test.TestClass",method="Add",le="+Inf"} 3` + "\n",
+		`dotnet_delegate_call_duration_seconds_count{assembly="Test",type="Test.TestClass",method="Divide"} 2` + "\n",
+	} {
+		if !strings.Contains(body, line) {
+			t.Fatalf("%q is missing:\n%s", line, body)
+		}
+	}
+	if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain; version=0.0.4") {
+		t.Fatalf("Got %s", contentType)
+	}
+
+	m.Publish("dotnet")
+	var published MetricsSnapshot
+	if err := json.Unmarshal([]byte(expvar.Get("dotnet").String()), &published); err != nil {
+		t.Fatal(err)
+	}
+	if published.LiveObjects != 1 || len(published.Methods) != 2 || published.Methods[0].Calls != 3 {
+		t.Fatalf("Got %+v", published)
+	}
+
+	// The plugin methods are released by Unload, and every method by Shutdown:
+	path := writeFile(t, tempDir(t, "metrics"), "Plugin.dll", readTestFile(t, "testfiles/plugin/v1/Plugin.dll"))
+	plugin, err := testRuntime.LoadPlugin(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := m.Snapshot().BoundDelegates; n != bound+2 {
+		t.Fatalf("Got %d bound delegates", n)
+	}
+	if err := plugin.Unload(); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.Snapshot().BoundDelegates; n != bound+1 {
+		t.Fatalf("Got %d bound delegates", n)
+	}
+	if err := testRuntime.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.Snapshot().BoundDelegates; n != 0 {
+		t.Fatalf("Got %d bound delegates", n)
+	}
+}
+
+func TestEscapeLabel(t *testing.T) {
+	if s := escapeLabel("a\"b\\c\nd"); s != `a\"b\\c\nd` {
+		t.Fatalf("Got %s", s)
+	}
+}
@@ -0,0 +1,342 @@
+package dotnet
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+// invokeArgs is passed to GoDotnet.Host.Invoke, which sets result.
+typedef struct invokeArgs {
+  int op;
+  uintptr_t handle;
+  const char* assembly;
+  const char* type;
+  const char* member;
+  const char* args;
+  char* result;
+  uint64_t task;
+  void* completion;
+} invokeArgs;
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// ErrObjectClosed is returned when an Object is used after Close.
+var ErrObjectClosed = errors.New("Object is closed")
+
+// Object operations, see GoDotnet.Host.Invoke.
+const (
+	objectNew = iota
+	objectCall
+	objectGet
+	objectSet
+	objectFree
+	objectCallAsync
+	objectCancel
+)
+
+// Object is a managed object held by a GCHandle, it stays alive until Close is called.
+//
+// Arguments, property values and results are converted like this: bool, the sized integers and floats, string
+// (or UTF16String) and []byte are passed by value, int and uint as int64 and uint64, nil as null and *Object as
+// the object it holds. Managed numbers are converted to the parameter types when they fit and the first overload
+// that accepts the arguments is called. Results use the same Go types, char as uint16, enums as their underlying
+// type, and the other objects are returned as new *Object values that must be closed too.
+//
+// An exception thrown by the managed code is returned as a *ManagedException. Objects are safe for concurrent use.
+type Object struct {
+	runtime *Runtime
+	class   string
+
+	// mu guards handle, it's 0 once the object is closed.
+	mu     sync.RWMutex
+	handle uintptr
+}
+
+// invocation describes a GoDotnet.Host.Invoke call, handle is 0 for New and the static methods.
+type invocation struct {
+	op                    int
+	handle                uintptr
+	assembly, typ, member string
+	args                  []interface{}
+
+	// task and completion are set for objectCallAsync and objectCancel.
+	task       uint64
+	completion unsafe.Pointer
+}
+
+// objectValue is the JSON form of the values passed to GoDotnet.Host.Invoke.
+type objectValue struct {
+	Type   string          `json:"type"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Handle uintptr         `json:"handle,omitempty"`
+	Class  string          `json:"class,omitempty"`
+}
+
+// New creates an instance of a public type of an assembly loaded in the default load context, calling the
+// public constructor that accepts args. A struct type without args returns its default value.
+func (r *Runtime) New(assembly, typ string, args ...interface{}) (*Object, error) {
+	result, err := r.invoke(invocation{op: objectNew, assembly: assembly, typ: typ, args: args})
+	if err != nil {
+		return nil, err
+	}
+	o, ok := result.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s.%s returned %T", ErrInvalidArgument, assembly, typ, result)
+	}
+	return o, nil
+}
+
+// Call calls a public method of the object, static methods included.
+func (o *Object) Call(method string, args ...interface{}) (interface{}, error) {
+	return o.invoke(invocation{op: objectCall, member: method, args: args})
+}
+
+// Get returns the value of a public property or field.
+func (o *Object) Get(property string) (interface{}, error) {
+	return o.invoke(invocation{op: objectGet, member: property})
+}
+
+// Set sets the value of a public property or field.
+func (o *Object) Set(property string, value interface{}) error {
+	_, err := o.invoke(invocation{op: objectSet, member: property, args: []interface{}{value}})
+	return err
+}
+
+// Type returns the full name of the object type, like System.Text.StringBuilder.
+func (o *Object) Type() string {
+	return o.class
+}
+
+// Handle returns the GCHandle, as an IntPtr for GCHandle.FromIntPtr. It's 0 once the object is closed.
+func (o *Object) Handle() uintptr {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.handle
+}
+
+// Close frees the handle, the managed object can be collected afterwards. Close can be called more than once.
+func (o *Object) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.handle == 0 {
+		return nil
+	}
+	runtime.SetFinalizer(o, nil)
+	_, err := o.runtime.invoke(invocation{op: objectFree, handle: o.handle})
+	o.handle = 0
+	o.runtime.untrackObject()
+	return err
+}
+
+// String returns the object type and handle.
+func (o *Object) String() string {
+	return fmt.Sprintf("%s(0x%x)", o.class, o.Handle())
+}
+
+func (o *Object) invoke(call invocation) (interface{}, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if o.handle == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrObjectClosed, o.class)
+	}
+	call.handle = o.handle
+	return o.runtime.invoke(call)
+}
+
+// newObject wraps a handle returned by the helper. A finalizer frees the handles that weren't closed
+// and logs them, the runtime must still be running.
+func (r *Runtime) newObject(handle uintptr, class string) *Object {
+	o := &Object{runtime: r, class: class, handle: handle}
+	runtime.SetFinalizer(o, func(o *Object) {
+		r.log(LevelWarn, "Object leaked, Close wasn't called", Fields{"type": o.class})
+		if r.State() == StateRunning {
+			r.invoke(invocation{op: objectFree, handle: o.handle})
+		}
+		r.untrackObject()
+	})
+	if r.metrics != nil {
+		r.metrics.addLiveObjects(1)
+	}
+	return o
+}
+
+// untrackObject removes a closed or leaked object from the metrics.
+func (r *Runtime) untrackObject() {
+	if r.metrics != nil {
+		r.metrics.addLiveObjects(-1)
+	}
+}
+
+// invoke calls GoDotnet.Host.Invoke.
+func (r *Runtime) invoke(call invocation) (interface{}, error) {
+	release, err := r.checkRunning(OpObject)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	values := make([]objectValue, len(call.args))
+	for i, arg := range call.args {
+		v, err := encodeObjectValue(arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		values[i] = v
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	invoke, err := r.invokeHelper()
+	if err != nil {
+		return nil, err
+	}
+	cArgs := C.invokeArgs{
+		op:         C.int(call.op),
+		handle:     C.uintptr_t(call.handle),
+		assembly:   C.CString(call.assembly),
+		_type:      C.CString(call.typ),
+		member:     C.CString(call.member),
+		args:       C.CString(string(encoded)),
+		task:       C.uint64_t(call.task),
+		completion: call.completion,
+	}
+	defer C.free(unsafe.Pointer(cArgs.assembly))
+	defer C.free(unsafe.Pointer(cArgs._type))
+	defer C.free(unsafe.Pointer(cArgs.member))
+	defer C.free(unsafe.Pointer(cArgs.args))
+	err = callHelper(OpObject, invoke, unsafe.Pointer(&cArgs), unsafe.Sizeof(cArgs))
+	// The *Object arguments must not be finalized during the call.
+	runtime.KeepAlive(call.args)
+	if err != nil {
+		return nil, err
+	}
+	return r.invokeResult(unsafe.Pointer(cArgs.result))
+}
+
+// invokeResult decodes and frees a result of GoDotnet.Host.Invoke.
+func (r *Runtime) invokeResult(p unsafe.Pointer) (interface{}, error) {
+	result := UTF8ToString(p)
+	FreeCoTaskMem(p)
+	var decoded struct {
+		Value     *objectValue      `json:"value"`
+		Exception *ManagedException `json:"exception"`
+	}
+	if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.Exception != nil {
+		return nil, decoded.Exception
+	}
+	return r.decodeObjectValue(decoded.Value)
+}
+
+// invokeHelper returns GoDotnet.Host.Invoke, it's created once per runtime.
+func (r *Runtime) invokeHelper() (unsafe.Pointer, error) {
+	r.objectsMu.Lock()
+	defer r.objectsMu.Unlock()
+	if r.invokeFunction == nil {
+		f, err := r.helperFunction("Invoke")
+		if err != nil {
+			return nil, err
+		}
+		r.invokeFunction = f
+	}
+	return r.invokeFunction, nil
+}
+
+func encodeObjectValue(v interface{}) (objectValue, error) {
+	var t string
+	switch v := v.(type) {
+	case nil:
+		return objectValue{Type: "null"}, nil
+	case *Object:
+		handle := v.Handle()
+		if handle == 0 {
+			return objectValue{}, fmt.Errorf("%w: %s", ErrObjectClosed, v.class)
+		}
+		return objectValue{Type: "object", Handle: handle}, nil
+	case bool:
+		t = "bool"
+	case int8:
+		t = "int8"
+	case int16:
+		t = "int16"
+	case int32:
+		t = "int32"
+	case int64, int:
+		t = "int64"
+	case uint8:
+		t = "uint8"
+	case uint16:
+		t = "uint16"
+	case uint32:
+		t = "uint32"
+	case uint64, uint:
+		t = "uint64"
+	case float32:
+		t = "float32"
+	case float64:
+		t = "float64"
+	case string, UTF16String:
+		t = "string"
+	case []byte:
+		t = "bytes"
+	default:
+		return objectValue{}, fmt.Errorf("%w: %T", ErrInvalidArgument, v)
+	}
+	value, err := json.Marshal(v)
+	return objectValue{Type: t, Value: value}, err
+}
+
+func (r *Runtime) decodeObjectValue(v *objectValue) (interface{}, error) {
+	if v == nil || v.Type == "null" {
+		return nil, nil
+	}
+	var result interface{}
+	switch v.Type {
+	case "object":
+		return r.newObject(v.Handle, v.Class), nil
+	case "bool":
+		result = new(bool)
+	case "int8":
+		result = new(int8)
+	case "int16":
+		result = new(int16)
+	case "int32":
+		result = new(int32)
+	case "int64":
+		result = new(int64)
+	case "uint8":
+		result = new(uint8)
+	case "uint16":
+		result = new(uint16)
+	case "uint32":
+		result = new(uint32)
+	case "uint64":
+		result = new(uint64)
+	case "float32":
+		result = new(float32)
+	case "float64":
+		result = new(float64)
+	case "string":
+		result = new(string)
+	case "bytes":
+		result = new([]byte)
+	default:
+		return nil, fmt.Errorf("%w: unknown managed value type %q", ErrInvalidArgument, v.Type)
+	}
+	if err := json.Unmarshal(v.Value, result); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(result).Elem().Interface(), nil
+}
@@ -0,0 +1,139 @@
+package dotnet
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestObject(t *testing.T) {
+	counter, err := testRuntime.New("Test", "Test.Counter", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer counter.Close()
+	if counter.Type() != "Test.Counter" || counter.Handle() == 0 {
+		t.Fatalf("Got %s", counter)
+	}
+	if v, err := counter.Get("Value"); v != int32(5) || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+	if err := counter.Set("Value", 7); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := counter.Call("Add", int8(3)); v != int32(10) || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+	if v, err := counter.Call("Add", 1, uint16(2)); v != int64(13) || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+	if err := counter.Set("Mode", int16(1)); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := counter.Get("Mode"); v != int16(1) || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+	if v, err := counter.Call("Bytes", []byte{1, 2}); !bytes.Equal(v.([]byte), []byte{1, 2, 13}) || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+
+	named, err := testRuntime.New("Test", "Test.Counter", "named", int64(13))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer named.Close()
+	if v, err := named.Get("Name"); v != "named" || err != nil {
+		t.Fatalf("Got %v, %v", v, err)
+	}
+	clone, err := named.Call("Clone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clone.(*Object).Close()
+	for _, other := range []interface{}{counter, clone} {
+		if same, err := named.Call("SameValue", other); same != true || err != nil {
+			t.Fatalf("Got %v, %v", same, err)
+		}
+	}
+	if same, err := named.Call("SameValue", nil); same != false || err != nil {
+		t.Fatalf("Got %v, %v", same, err)
+	}
+
+	var e *ManagedException
+	if _, err := named.Call("Fail"); !errors.As(err, &e) || e.Type != "System.InvalidOperationException" || e.Message != "counter named" {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := named.Call("Missing"); !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := named.Call("Add", 1<<40); !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := named.Set("Value", "text"); !errors.Is(err, ErrManagedException) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := named.Call("Add", struct{}{}); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.New("Test", "Test.Missing"); !errors.Is(err, ErrTypeLoadException) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.New("Test", "Test.Counter", "name", 1<<40); !errors.Is(err, ErrManagedException) {
+		t.Fatalf("Got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := named.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := named.Get("Name"); !errors.Is(err, ErrObjectClosed) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := counter.Call("SameValue", named); !errors.Is(err, ErrObjectClosed) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+func TestObjectLeak(t *testing.T) {
+	testLogger.record(t)
+	leaked := func() (Fields, bool) {
+		for _, e := range testLogger.entries() {
+			if e.level == LevelWarn && e.msg == "Object leaked, Close wasn't called" {
+				return e.fields, true
+			}
+		}
+		return nil, false
+	}
+
+	counter, err := testRuntime.New("Test", "Test.Counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer counter.Close()
+	live, _ := counter.Call("LiveCounters")
+	func() {
+		if _, err := testRuntime.New("Test", "Test.Counter"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	for i := 0; ; i++ {
+		runtime.GC()
+		if fields, ok := leaked(); ok {
+			if fields["type"] != "Test.Counter" {
+				t.Fatalf("Got %v", fields)
+			}
+			// The finalizer freed the handle:
+			if after, _ := counter.Call("LiveCounters"); after != live {
+				t.Fatalf("Got %v live counters, expected %v", after, live)
+			}
+			return
+		}
+		if i == 20 {
+			t.Fatal("The leak wasn't logged")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
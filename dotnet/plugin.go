@@ -0,0 +1,319 @@
+package dotnet
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+// loadPluginArgs is passed to GoDotnet.Host.LoadPlugin, which sets context and name.
+typedef struct loadPluginArgs {
+  const char* path;
+  int64_t context;
+  char* name;
+} loadPluginArgs;
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// ErrPluginUnloaded matches every UnloadedError.
+var ErrPluginUnloaded = errors.New("Plugin is unloaded")
+
+// UnloadedError is returned by the delegates and methods of a Plugin after Unload.
+type UnloadedError struct {
+	// Plugin is the plugin path.
+	Plugin string
+	// Method is the method of the delegate, like Rules.Engine.Evaluate, empty for the Plugin methods.
+	Method string
+}
+
+// Error implements the error interface.
+func (e *UnloadedError) Error() string {
+	if e.Method == "" {
+		return fmt.Sprintf("%s is unloaded", e.Plugin)
+	}
+	return fmt.Sprintf("%s failed: %s is unloaded", e.Method, e.Plugin)
+}
+
+// Is reports whether target is ErrPluginUnloaded.
+func (e *UnloadedError) Is(target error) bool {
+	return target == ErrPluginUnloaded
+}
+
+// Plugin is an assembly loaded in its own collectible AssemblyLoadContext, it can be reloaded and unloaded
+// without restarting the process. The assemblies in the same directory are loaded in the context too, the others
+// (the framework ones included) are shared with the default context. The files are read in memory, they can
+// be replaced while the plugin is loaded.
+//
+// The delegates created by NewDelegate and BindPlugin are bound to the loaded context: Reload binds them again
+// to the new one, and once the plugin is unloaded their calls return an *UnloadedError instead of calling
+// the unloaded code. Unload and Reload wait for the running calls.
+type Plugin struct {
+	runtime *Runtime
+	path    string
+
+	// mu guards the load context and the delegates bound to it, context is 0 once the plugin is unloaded.
+	// loaded is the stamp of the file taken before it was loaded, see Watch.
+	mu        sync.RWMutex
+	context   uint64
+	name      string
+	delegates []*Delegate
+	loaded    fileStamp
+
+	watchMu   sync.Mutex
+	stopWatch func()
+}
+
+// LoadPlugin loads the assembly at path in a new collectible load context.
+func (r *Runtime) LoadPlugin(path string) (*Plugin, error) {
+	p := &Plugin{runtime: r, path: path}
+	// A change made while the file is loaded is reloaded by Watch.
+	p.loaded, _ = p.stamp()
+	context, name, err := r.loadPlugin(path)
+	if err != nil {
+		return nil, err
+	}
+	p.context, p.name = context, name
+	r.addPlugin(p)
+	return p, nil
+}
+
+// Path returns the path of the plugin assembly.
+func (p *Plugin) Path() string {
+	return p.path
+}
+
+// Name returns the name of the plugin assembly, like Rules.
+func (p *Plugin) Name() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.name
+}
+
+// NewDelegate works like Runtime.NewDelegate for a static method of the plugin assembly.
+func (p *Plugin) NewDelegate(typ, method, signature string) (*Delegate, error) {
+	sig, err := parseSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	return p.newDelegate(typ, method, nil, sig)
+}
+
+// BindPlugin works like Bind for a static method of the plugin assembly. The function returns an *UnloadedError
+// after Unload, in its error result or as a panic.
+func BindPlugin[F any](p *Plugin, typ, method string) (F, error) {
+	var fn F
+	t := reflect.TypeOf(&fn).Elem()
+	sig, err := funcSignature(t)
+	if err != nil {
+		return fn, err
+	}
+	d, err := p.newDelegate(typ, method, t, sig)
+	if err != nil {
+		return fn, err
+	}
+	reflect.ValueOf(&fn).Elem().Set(reflect.MakeFunc(t, d.reflectCall(t)))
+	return fn, nil
+}
+
+func (p *Plugin) newDelegate(typ, method string, t reflect.Type, sig signature) (*Delegate, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.context == 0 {
+		return nil, &UnloadedError{Plugin: p.path}
+	}
+	d := &Delegate{runtime: p.runtime, assembly: p.name, typ: typ, method: method, sig: sig, goType: t, plugin: p}
+	b, err := p.runtime.bind(d, p.context)
+	if err != nil {
+		return nil, err
+	}
+	d.binding = b
+	p.runtime.trackDelegate(d)
+	p.delegates = append(p.delegates, d)
+	return d, nil
+}
+
+// Reload loads the plugin file again in a new context, binds the delegates to it and unloads the previous one.
+// The previous context stays loaded when the new one can't be loaded or a delegate can't be bound to it,
+// like when a method was removed.
+func (p *Plugin) Reload() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.context == 0 {
+		return &UnloadedError{Plugin: p.path}
+	}
+	loaded, _ := p.stamp()
+	context, name, err := p.runtime.loadPlugin(p.path)
+	if err != nil {
+		return err
+	}
+	bindings := make([]binding, len(p.delegates))
+	for i, d := range p.delegates {
+		// The delegates keep the assembly name they were bound with.
+		if bindings[i], err = p.runtime.bind(d, context); err != nil {
+			p.runtime.unloadPlugin(context)
+			return fmt.Errorf("%s: %w", d, err)
+		}
+	}
+	for i, d := range p.delegates {
+		d.binding = bindings[i]
+	}
+	previous := p.context
+	p.context, p.name, p.loaded = context, name, loaded
+	return p.runtime.unloadPlugin(previous)
+}
+
+// Unload stops watching the plugin file, waiting for a running Reload, and unloads the plugin, its delegates
+// return an *UnloadedError afterwards.
+// The managed code is collected once it doesn't run anymore, on managed threads included.
+// Unload can be called more than once.
+func (p *Plugin) Unload() error {
+	// The watcher reloads the plugin with p.mu held, it's stopped first:
+	p.watchMu.Lock()
+	if p.stopWatch != nil {
+		p.stopWatch()
+		p.stopWatch = nil
+	}
+	p.watchMu.Unlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.context == 0 {
+		return nil
+	}
+	context := p.context
+	p.context = 0
+	if p.runtime.metrics != nil {
+		for _, d := range p.delegates {
+			p.runtime.metrics.release(d.key())
+		}
+	}
+	p.delegates = nil
+	p.runtime.removePlugin(p)
+	return p.runtime.unloadPlugin(context)
+}
+
+// Watch polls the plugin file every interval and calls Reload when it changed, once its size and modification
+// time stayed the same for an interval so that a file being copied isn't loaded. Reload errors are logged and
+// the plugin keeps running the loaded version. A new Watch call replaces the previous one, the returned function
+// and Unload stop watching: they return once the watching goroutine exited, after the running Reload.
+func (p *Plugin) Watch(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			close(done)
+		})
+		<-finished
+	}
+	p.watchMu.Lock()
+	if p.stopWatch != nil {
+		p.stopWatch()
+	}
+	p.stopWatch = stop
+	p.watchMu.Unlock()
+
+	p.mu.RLock()
+	loaded := p.loaded
+	p.mu.RUnlock()
+	go p.watch(interval, loaded, done, finished)
+	return stop
+}
+
+// fileStamp identifies a version of a file.
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+func (p *Plugin) stamp() (fileStamp, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{size: info.Size(), modTime: info.ModTime()}, nil
+}
+
+// watch reloads the plugin when its file doesn't match the loaded stamp anymore, until done is closed.
+// It closes finished when it returns.
+func (p *Plugin) watch(interval time.Duration, loaded fileStamp, done, finished chan struct{}) {
+	defer close(finished)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var changed *fileStamp
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		s, err := p.stamp()
+		switch {
+		case err != nil:
+			// The file is being replaced.
+			continue
+		case s == loaded:
+			changed = nil
+			continue
+		case changed == nil || *changed != s:
+			changed = &s
+			continue
+		}
+		loaded, changed = s, nil
+		fields := Fields{FieldPath: p.path}
+		if err := p.Reload(); errors.Is(err, ErrPluginUnloaded) {
+			return
+		} else if err != nil {
+			fields["error"] = err.Error()
+			p.runtime.log(LevelError, "Can't reload the plugin", fields)
+		} else {
+			p.runtime.log(LevelInfo, "Plugin reloaded", fields)
+		}
+	}
+}
+
+// loadPlugin calls GoDotnet.Host.LoadPlugin and returns the context ID and the assembly name.
+func (r *Runtime) loadPlugin(path string) (uint64, string, error) {
+	release, err := r.checkRunning(OpLoadPlugin)
+	if err != nil {
+		return 0, "", err
+	}
+	defer release()
+	loadPlugin, err := r.helperFunction("LoadPlugin")
+	if err != nil {
+		return 0, "", err
+	}
+	args := C.loadPluginArgs{path: C.CString(path)}
+	defer C.free(unsafe.Pointer(args.path))
+	if err := callHelper(OpLoadPlugin, loadPlugin, unsafe.Pointer(&args), unsafe.Sizeof(args)); err != nil {
+		r.log(LevelError, "Can't load the plugin", Fields{FieldPath: path, "error": err.Error()})
+		return 0, "", err
+	}
+	name := C.GoString(args.name)
+	FreeCoTaskMem(unsafe.Pointer(args.name))
+	r.log(LevelDebug, "Loaded a plugin", Fields{FieldPath: path, "assembly": name})
+	return uint64(args.context), name, nil
+}
+
+// unloadPlugin calls GoDotnet.Host.UnloadPlugin.
+func (r *Runtime) unloadPlugin(context uint64) error {
+	release, err := r.checkRunning(OpLoadPlugin)
+	if err != nil {
+		return err
+	}
+	defer release()
+	unloadPlugin, err := r.helperFunction("UnloadPlugin")
+	if err != nil {
+		return err
+	}
+	id := C.int64_t(context)
+	return callHelper(OpLoadPlugin, unloadPlugin, unsafe.Pointer(&id), unsafe.Sizeof(id))
+}
@@ -0,0 +1,130 @@
+package dotnet
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+// pluginPair matches Plugin.Pair.
+type pluginPair struct {
+	A, B int32
+}
+
+func readTestFile(t *testing.T, path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestPlugin(t *testing.T) {
+	dir := tempDir(t, "plugin")
+	v1 := readTestFile(t, "testfiles/plugin/v1/Plugin.dll")
+	v2 := readTestFile(t, "testfiles/plugin/v2/Plugin.dll")
+	path := writeFile(t, dir, "Plugin.dll", v1)
+	writeFile(t, dir, "EmbeddedUtil.dll", readTestFile(t, "testfiles/embedded/EmbeddedUtil.dll"))
+
+	contexts, err := Bind[func(string) int32](testRuntime, "Test", "Test.TestClass", "LoadContexts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin, err := testRuntime.LoadPlugin(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plugin.Unload()
+	if n := contexts("Plugin.dll"); n != 1 {
+		t.Fatalf("Got %d plugin contexts", n)
+	}
+	if plugin.Name() != "Plugin" || plugin.Path() != path {
+		t.Fatalf("Got %s, %s", plugin.Name(), plugin.Path())
+	}
+	if _, err := Bind[func() int32](testRuntime, "Plugin", "Plugin.Rules", "Version"); !errors.Is(err, ErrAssemblyNotFound) {
+		t.Fatalf("Got %v", err)
+	}
+	version, err := BindPlugin[func() (int32, error)](plugin, "Plugin.Rules", "Version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := BindPlugin[func(*pluginPair) int32](plugin, "Plugin.Rules", "Sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := version(); v != 1 || err != nil {
+		t.Fatalf("Got %d, %v", v, err)
+	}
+	for i := int32(1); i <= 2; i++ {
+		if n, err := calls.Call(); n != i || err != nil {
+			t.Fatalf("Got %v, %v", n, err)
+		}
+	}
+
+	// The delegates are bound to the new context:
+	writeFile(t, dir, "Plugin.dll", v2)
+	if err := plugin.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := version(); v != 2 || err != nil {
+		t.Fatalf("Got %d, %v", v, err)
+	}
+	if n, err := calls.Call(); n != int32(1) || err != nil {
+		t.Fatalf("Got %v, %v", n, err)
+	}
+	if n := sum(&pluginPair{2, 3}); n != 5 {
+		t.Fatalf("Got %d", n)
+	}
+
+	// A broken file keeps the loaded version:
+	writeFile(t, dir, "Plugin.dll", "broken")
+	if err := plugin.Reload(); !errors.Is(err, ErrBadImageFormat) {
+		t.Fatalf("Got %v", err)
+	}
+	if v, err := version(); v != 2 || err != nil {
+		t.Fatalf("Got %d, %v", v, err)
+	}
+
+	stop := plugin.Watch(10 * time.Millisecond)
+	defer stop()
+	writeFile(t, dir, "Plugin.dll", v1)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if v, _ := version(); v == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("The plugin wasn't reloaded")
+		}
+	}
+	// The watcher exited once stop returns:
+	stop()
+
+	for i := 0; i < 2; i++ {
+		if err := plugin.Unload(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var unloaded *UnloadedError
+	if _, err := version(); !errors.As(err, &unloaded) || unloaded.Method != "Plugin.Rules.Version" || unloaded.Plugin != path {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := calls.Call(); !errors.Is(err, ErrPluginUnloaded) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()"); !errors.Is(err, ErrPluginUnloaded) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := plugin.Reload(); !errors.Is(err, ErrPluginUnloaded) {
+		t.Fatalf("Got %v", err)
+	}
+
+	// The contexts are collected:
+	if n := contexts("Plugin.dll"); n != 0 {
+		t.Fatalf("%d plugin contexts are still loaded", n)
+	}
+}
@@ -0,0 +1,139 @@
+package dotnet
+
+import (
+	"errors"
+	"sort"
+	"unsafe"
+)
+
+// ErrDelegateIDInUse is returned by CreateDelegate when the delegate ID is registered for another method.
+var ErrDelegateIDInUse = errors.New("Delegate ID is already in use")
+
+// DelegateBinding declares a CreateDelegate call, see RuntimeParams.Delegates:
+//
+//	dotnet.DelegateBinding{ID: 1, Assembly: "Test", Type: "Test.TestClass", Method: "Add", Target: &addFunc}
+type DelegateBinding struct {
+	// ID registers the delegate, see CreateDelegate. 0 doesn't register it.
+	ID       int
+	Assembly string
+	Type     string
+	Method   string
+	// Target receives the function pointer.
+	Target *unsafe.Pointer
+}
+
+// RegisteredDelegate describes a delegate of the runtime, see Runtime.Delegates.
+type RegisteredDelegate struct {
+	MethodKey
+	// ID is the delegate ID passed to CreateDelegate, 0 for the methods that weren't registered with one.
+	ID int `json:"id,omitempty"`
+	// Plugin is the path of the plugin of the delegates created by a Plugin.
+	Plugin  string         `json:"plugin,omitempty"`
+	Pointer unsafe.Pointer `json:"-"`
+}
+
+// LookupDelegate returns the function pointer registered under a delegate ID by CreateDelegate.
+// It returns false after Shutdown.
+func (r *Runtime) LookupDelegate(id int) (unsafe.Pointer, bool) {
+	r.delegatesMu.Lock()
+	defer r.delegatesMu.Unlock()
+	key, ok := r.delegateIDs[id]
+	if !ok {
+		return nil, false
+	}
+	return r.delegatePointers[key], true
+}
+
+// Delegates lists the delegates created by CreateDelegate, one per delegate ID and one for each method that
+// wasn't registered with an ID, and the delegates of the loaded plugins. The plugin ones are removed when
+// the plugin is unloaded and the list is empty after Shutdown. The delegates are sorted by plugin and method.
+func (r *Runtime) Delegates() []RegisteredDelegate {
+	r.delegatesMu.Lock()
+	var delegates []RegisteredDelegate
+	registered := make(map[MethodKey]bool, len(r.delegateIDs))
+	for id, key := range r.delegateIDs {
+		registered[key] = true
+		delegates = append(delegates, RegisteredDelegate{MethodKey: key, ID: id, Pointer: r.delegatePointers[key]})
+	}
+	for key, pointer := range r.delegatePointers {
+		if !registered[key] {
+			delegates = append(delegates, RegisteredDelegate{MethodKey: key, Pointer: pointer})
+		}
+	}
+	plugins := make([]*Plugin, 0, len(r.plugins))
+	for p := range r.plugins {
+		plugins = append(plugins, p)
+	}
+	// Unload locks the plugin before the registry, the plugins are read afterwards:
+	r.delegatesMu.Unlock()
+
+	for _, p := range plugins {
+		p.mu.RLock()
+		for _, d := range p.delegates {
+			delegates = append(delegates, RegisteredDelegate{
+				MethodKey: d.key(),
+				Plugin:    p.path,
+				Pointer:   d.f,
+			})
+		}
+		p.mu.RUnlock()
+	}
+	sort.Slice(delegates, func(i, j int) bool {
+		a, b := delegates[i], delegates[j]
+		if a.Plugin != b.Plugin {
+			return a.Plugin < b.Plugin
+		}
+		if a.MethodKey != b.MethodKey {
+			return a.Assembly < b.Assembly || a.Assembly == b.Assembly &&
+				(a.Type < b.Type || a.Type == b.Type && a.Method < b.Method)
+		}
+		return a.ID < b.ID
+	})
+	return delegates
+}
+
+// addPlugin adds a loaded plugin to the registry, its delegates are listed by Delegates.
+func (r *Runtime) addPlugin(p *Plugin) {
+	r.delegatesMu.Lock()
+	defer r.delegatesMu.Unlock()
+	if r.plugins == nil {
+		r.plugins = make(map[*Plugin]bool)
+	}
+	r.plugins[p] = true
+}
+
+// removePlugin removes an unloaded plugin from the registry.
+func (r *Runtime) removePlugin(p *Plugin) {
+	r.delegatesMu.Lock()
+	defer r.delegatesMu.Unlock()
+	delete(r.plugins, p)
+}
+
+// clearDelegates empties the registry, it's called by Shutdown.
+func (r *Runtime) clearDelegates() {
+	r.delegatesMu.Lock()
+	defer r.delegatesMu.Unlock()
+	r.delegatePointers = nil
+	r.delegateIDs = nil
+}
+
+// unloadPlugins marks the loaded plugins as unloaded and stops watching them, it's called by Shutdown
+// once the running calls completed. The load contexts go away with the runtime.
+func (r *Runtime) unloadPlugins() {
+	r.delegatesMu.Lock()
+	plugins := r.plugins
+	r.plugins = nil
+	r.delegatesMu.Unlock()
+	for p := range plugins {
+		p.watchMu.Lock()
+		if p.stopWatch != nil {
+			p.stopWatch()
+			p.stopWatch = nil
+		}
+		p.watchMu.Unlock()
+		p.mu.Lock()
+		p.context = 0
+		p.delegates = nil
+		p.mu.Unlock()
+	}
+}
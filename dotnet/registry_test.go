@@ -0,0 +1,80 @@
+package dotnet
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/matiasinsaurralde/go-dotnet/dotnet/internal/testhelper"
+)
+
+func TestDelegateRegistry(t *testing.T) {
+	add, ok := testRuntime.LookupDelegate(testAddID)
+	if !ok || add == nil || add != *testhelper.GetAddFunc() {
+		t.Fatalf("Got %v, %v", add, ok)
+	}
+	if _, ok := testRuntime.LookupDelegate(0); ok {
+		t.Fatal("Delegate 0 is registered")
+	}
+	var f unsafe.Pointer
+	if err := testRuntime.CreateDelegate("Test", "Test.TestClass", "Add", 0, &f); err != nil || f != add {
+		t.Fatalf("Got %v, %v", f, err)
+	}
+	if err := testRuntime.CreateDelegate("Test", "Test.TestClass", "Add", 100, &f); err != nil || f != add {
+		t.Fatalf("Got %v, %v", f, err)
+	}
+	if f, ok := testRuntime.LookupDelegate(100); !ok || f != add {
+		t.Fatalf("Got %v, %v", f, ok)
+	}
+	if err := testRuntime.CreateDelegate("Test", "Test.TestClass", "String", testAddID, &f); !errors.Is(err, ErrDelegateIDInUse) {
+		t.Fatalf("Got %v", err)
+	}
+	if n := testhelper.CallAddFunc(2, 3); n != 5 {
+		t.Fatalf("Got %d", n)
+	}
+
+	dir := tempDir(t, "registry")
+	path := writeFile(t, dir, "Plugin.dll", readTestFile(t, "testfiles/plugin/v1/Plugin.dll"))
+	plugin, err := testRuntime.LoadPlugin(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plugin.Unload()
+	calls, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[int]RegisteredDelegate)
+	var pluginDelegates []RegisteredDelegate
+	for _, d := range testRuntime.Delegates() {
+		switch {
+		case d.Plugin == path:
+			pluginDelegates = append(pluginDelegates, d)
+		case d.ID != 0:
+			ids[d.ID] = d
+		}
+	}
+	if d := ids[testAddID]; d.MethodKey != (MethodKey{"Test", "Test.TestClass", "Add"}) || d.Pointer != add {
+		t.Fatalf("Got %+v", d)
+	}
+	if d := ids[100]; d.Method != "Add" || d.Pointer != add {
+		t.Fatalf("Got %+v", d)
+	}
+	if d := ids[testGetDataID]; d.Method != "GetData" || d.Pointer != *testhelper.GetGetDataFunc() {
+		t.Fatalf("Got %+v", d)
+	}
+	if len(pluginDelegates) != 1 || pluginDelegates[0].MethodKey != (MethodKey{"Plugin", "Plugin.Rules", "Calls"}) ||
+		pluginDelegates[0].Pointer != calls.Pointer() {
+		t.Fatalf("Got %+v", pluginDelegates)
+	}
+
+	if err := plugin.Unload(); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range testRuntime.Delegates() {
+		if d.Plugin != "" {
+			t.Fatalf("Got %+v", d)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 #include <stdio.h>
+#include <stdarg.h>
 #include <cstdlib>
-#include <sstream>
 #include <dlfcn.h>
 #include <limits.h>
 #include <string>
@@ -14,12 +14,20 @@
 
 #include "runtime.hpp"
 
-int initializeCoreCLR(const char* exePath,
+void setHostError(hostError* error, const char* stage, const char* format, ...) {
+  error->stage = stage;
+  va_list args;
+  va_start(args, format);
+  vsnprintf(error->message, HOST_ERROR_SIZE, format, args);
+  va_end(args);
+}
+
+int initializeCoreCLR(coreclrHost* host,
+            const char* exePath,
             const char* appDomainFriendlyName,
             int propertyCount,
-            const char* mergedPropertyKeys,
-            const char* mergedPropertyValues,
-            const char* managedAssemblyAbsolutePath,
+            const char** propertyKeys,
+            const char** propertyValues,
             const char* clrFilesAbsolutePath) {
 
   std::string coreClrDllPath(clrFilesAbsolutePath);
@@ -28,157 +36,109 @@ int initializeCoreCLR(const char* exePath,
 
   if (coreClrDllPath.length() >= PATH_MAX)
   {
-      fprintf(stderr, "Absolute path to libcoreclr.so too long\n");
+      setHostError(&host->error, "dlopen", "Absolute path to libcoreclr.so too long");
+      return COR_E_DLLNOTFOUND;
   }
 
-  std::string appPath;
-
-  if( managedAssemblyAbsolutePath[0] == '\0' ) {
-    // printf("Expecting to run a standard .exe\n");
-  } else {
-    // printf("Expecting to load an assembly and invoke arbitrary methods.\n");
-    GetDirectory(managedAssemblyAbsolutePath, appPath);
-  };
-
-  // Construct native search directory paths
-  std::string nativeDllSearchDirs(appPath);
-  char *coreLibraries = getenv("CORE_LIBRARIES");
-  if (coreLibraries)
+  host->coreclrLib = dlopen(coreClrDllPath.c_str(), RTLD_NOW | RTLD_LOCAL);
+  if (host->coreclrLib == nullptr)
   {
-      nativeDllSearchDirs.append(":");
-      nativeDllSearchDirs.append(coreLibraries);
+      setHostError(&host->error, "dlopen", "dlopen failed to open the libcoreclr.so with error %s", dlerror());
+      return COR_E_DLLNOTFOUND;
   }
-  nativeDllSearchDirs.append(":");
-  nativeDllSearchDirs.append(clrFilesAbsolutePath);
 
-  std::string tpaList;
-  AddFilesFromDirectoryToTpaList(clrFilesAbsolutePath, tpaList);
+  coreclr_initialize_ptr initialize_core_clr = (coreclr_initialize_ptr)dlsym(host->coreclrLib, "coreclr_initialize");
+  coreclr_execute_assembly_ptr execute_assembly = (coreclr_execute_assembly_ptr)dlsym(host->coreclrLib, "coreclr_execute_assembly");
+  coreclr_shutdown_ptr shutdown_core_clr = (coreclr_shutdown_ptr)dlsym(host->coreclrLib, "coreclr_shutdown");
+  coreclr_create_delegate_ptr create_delegate = (coreclr_create_delegate_ptr)dlsym(host->coreclrLib, "coreclr_create_delegate");
 
-  coreclrLib = dlopen(coreClrDllPath.c_str(), RTLD_NOW | RTLD_LOCAL);
-  if (coreclrLib != nullptr)
+  host->initializeCoreCLR = (void*)initialize_core_clr;
+  host->executeAssembly = (void*)execute_assembly;
+  host->shutdownCoreCLR = (void*)shutdown_core_clr;
+  host->shutdownCoreCLR2 = dlsym(host->coreclrLib, "coreclr_shutdown_2");
+  host->createDelegate = (void*)create_delegate;
+
+  if (initialize_core_clr == nullptr)
   {
-      initialize_core_clr = (coreclr_initialize_ptr)dlsym(coreclrLib, "coreclr_initialize");
-      execute_assembly = (coreclr_execute_assembly_ptr)dlsym(coreclrLib, "coreclr_execute_assembly");
-      shutdown_core_clr= (coreclr_shutdown_ptr)dlsym(coreclrLib, "coreclr_shutdown");
-      create_delegate = (coreclr_create_delegate_ptr)dlsym(coreclrLib, "coreclr_create_delegate");
-
-      if (initialize_core_clr == nullptr)
-      {
-          fprintf(stderr, "Function coreclr_initialize not found in the libcoreclr.so\n");
-          return -1;
-      }
-      else if (execute_assembly == nullptr)
-      {
-          fprintf(stderr, "Function coreclr_execute_assembly not found in the libcoreclr.so\n");
-          return -1;
-      }
-      else if (shutdown_core_clr == nullptr)
-      {
-          fprintf(stderr, "Function coreclr_shutdown not found in the libcoreclr.so\n");
-          return -1;
-      } else {
-        if(useServerGc == NULL) {
-          std::getenv(serverGcVar);
-          if (useServerGc == nullptr) {
-              useServerGc = "0";
-          }
-        }
-
-        useServerGc = std::strcmp(useServerGc, "1") == 0 ? "true" : "false";
-
-        // Keep enough space for inserting the tpaList:
-        propertyCount++;
-
-        char *keys[propertyCount];
-        char *values[propertyCount];
-
-        parseValues(mergedPropertyKeys, keys, propertyCount);
-        parseValues(mergedPropertyValues, values, propertyCount);
-
-        bool tpaOverride = false;
-
-        const char *tpaKey = "TRUSTED_PLATFORM_ASSEMBLIES";
-
-        for( int i = 0; i < propertyCount ; i++ ) {
-          int match = strncmp( tpaKey, keys[i], strlen(tpaKey) );
-          if( match == 0 ) {
-            tpaOverride = true;
-            break;
-          }
-        };
-
-        if( !tpaOverride ) {
-          keys[propertyCount] = (char*)std::malloc(strlen(tpaKey)+1);
-          std::strcpy(keys[propertyCount], tpaKey);
-
-          values[propertyCount] = (char*)std::malloc(strlen(tpaList.c_str())+1);
-          std::strcpy(values[propertyCount], tpaList.c_str());
-
-          propertyCount++;
-        };
-
-        int st = initialize_core_clr(
-                    exePath,
-                    appDomainFriendlyName,
-                    propertyCount,
-                    (const char**)keys,
-                    (const char**)values,
-                    &hostHandle,
-                    &domainId);
-
-        if (SUCCEEDED(st)) {
-          // printf("coreclr_initialize ok\n");
-        } else {
-          fprintf(stderr, "coreclr_initialize failed - status: 0x%08x\n", st);
-        };
-
-      }
-    }
+      setHostError(&host->error, "dlsym", "Function coreclr_initialize not found in the libcoreclr.so");
+      return COR_E_ENTRYPOINTNOTFOUND;
+  }
+  else if (execute_assembly == nullptr)
+  {
+      setHostError(&host->error, "dlsym", "Function coreclr_execute_assembly not found in the libcoreclr.so");
+      return COR_E_ENTRYPOINTNOTFOUND;
+  }
+  else if (shutdown_core_clr == nullptr)
+  {
+      setHostError(&host->error, "dlsym", "Function coreclr_shutdown not found in the libcoreclr.so");
+      return COR_E_ENTRYPOINTNOTFOUND;
+  }
+  else if (create_delegate == nullptr)
+  {
+      setHostError(&host->error, "dlsym", "Function coreclr_create_delegate not found in the libcoreclr.so");
+      return COR_E_ENTRYPOINTNOTFOUND;
+  }
+
+  int st = initialize_core_clr(
+              exePath,
+              appDomainFriendlyName,
+              propertyCount,
+              propertyKeys,
+              propertyValues,
+              &host->hostHandle,
+              &host->domainId);
 
-    return 0;
+  if (!SUCCEEDED(st)) {
+    setHostError(&host->error, "coreclr_initialize", "coreclr_initialize failed - status: 0x%08x", st);
+    return st;
+  };
 
+  return 0;
 }
 
-int shutdownCoreCLR() {
-  int st = shutdown_core_clr(hostHandle, domainId);
+// shutdownCoreCLR calls coreclr_shutdown_2 when it's available and stores the latched exit code
+// (Environment.ExitCode) in exitCode, otherwise coreclr_shutdown is called and exitCode isn't set.
+int shutdownCoreCLR(coreclrHost* host, int* exitCode) {
+  coreclr_shutdown_ptr shutdown_core_clr = (coreclr_shutdown_ptr)host->shutdownCoreCLR;
+  if (shutdown_core_clr == nullptr) {
+    setHostError(&host->error, "lifecycle", "coreclr_shutdown called before the runtime was loaded");
+    return HOST_E_INVALIDOPERATION;
+  }
+  coreclr_shutdown_2_ptr shutdown_core_clr_2 = (coreclr_shutdown_2_ptr)host->shutdownCoreCLR2;
+  if (shutdown_core_clr_2 != nullptr) {
+    int st = shutdown_core_clr_2(host->hostHandle, host->domainId, exitCode);
+    if (!SUCCEEDED(st)) {
+      setHostError(&host->error, "coreclr_shutdown_2", "coreclr_shutdown_2 failed - status: 0x%08x", st);
+    }
+    return st;
+  }
+  int st = shutdown_core_clr(host->hostHandle, host->domainId);
   if (!SUCCEEDED(st)) {
-    fprintf(stderr, "coreclr_shutdown failed - status: 0x%08x\n", st);
-    return -1;
+    setHostError(&host->error, "coreclr_shutdown", "coreclr_shutdown failed - status: 0x%08x", st);
   }
   return st;
 };
 
-int executeManagedAssembly(const char *assembly) {
-  printf("Executing: %s\n", assembly);
-
-  unsigned int* exitCode;
-  int st = execute_assembly(
-          hostHandle,
-          domainId,
-          0,
-          NULL,
+int executeManagedAssembly(coreclrHost* host, const char *assembly, int argc, const char** argv, unsigned int* exitCode) {
+  coreclr_execute_assembly_ptr execute_assembly = (coreclr_execute_assembly_ptr)host->executeAssembly;
+  if (execute_assembly == nullptr) {
+    setHostError(&host->error, "lifecycle", "coreclr_execute_assembly called before the runtime was loaded");
+    return HOST_E_INVALIDOPERATION;
+  }
+  return execute_assembly(
+          host->hostHandle,
+          host->domainId,
+          argc,
+          argv,
           assembly,
-          (unsigned int*)&exitCode);
-
-  if (!SUCCEEDED(st)) {
-    return st;
-  };
-  return 0;
+          exitCode);
 };
 
-void parseValues(const char* input, char** dest, int count) {
-  std::stringstream values(input);
-  std::string e;
-
-  int i = 0;
-  while( std::getline(values, e, ';')) {
-    const char *v = e.c_str();
-    dest[i] = (char*)std::malloc(strlen(v)+1);
-    std::strcpy(dest[i], v);
-    i++;
+int createDelegate(coreclrHost* host, const char* entryPointAssemblyName, const char* entryPointTypeName, const char* entryPointMethodName, void** f) {
+  coreclr_create_delegate_ptr create_delegate = (coreclr_create_delegate_ptr)host->createDelegate;
+  if (create_delegate == nullptr) {
+    setHostError(&host->error, "lifecycle", "coreclr_create_delegate called before the runtime was loaded");
+    return HOST_E_INVALIDOPERATION;
   }
-};
-
- int createDelegate(const char* entryPointAssemblyName, const char* entryPointTypeName, const char* entryPointMethodName, int delegateID, void** f) {
-   return create_delegate(hostHandle, domainId, entryPointAssemblyName, entryPointTypeName, entryPointMethodName, f);
- }
\ No newline at end of file
+  return create_delegate(host->hostHandle, host->domainId, entryPointAssemblyName, entryPointTypeName, entryPointMethodName, f);
+}
@@ -5,222 +5,601 @@ package dotnet
 #cgo linux LDFLAGS: -ldl
 #include <stdlib.h>
 #include "runtime.hpp"
+#include "hostfxr.hpp"
 */
 import "C"
 
 import (
-	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/kardianos/osext"
 )
 
 const (
-	assemblyNotFound       = 0x80070002
-	typeLoadException      = 0x80131522
-	missingMethodException = 0x80131513
-	nullReferenceException = 0x80004003
-
 	defaultAppDomainFriendlyName = "app"
+
+	tpaProperty = "TRUSTED_PLATFORM_ASSEMBLIES"
 )
 
 var (
-	runtimeInstance = &Runtime{}
-
-	errAssemblyNotFound       = errors.New("Assembly not found")
-	errTypeLoadException      = errors.New("Missing type")
-	errMissingMethodException = errors.New("Missing method")
-	errNullReferenceException = errors.New("Invalid delegate function pointer")
-
-	linuxSDKPaths = []string{
-		"/usr/share/dotnet/shared/Microsoft.NETCore.App",
-		"$HOME/.dotnet/shared/Microsoft.NETCore.App",
-	}
+	// defaultRuntime backs the package-level functions kept for backwards compatibility.
+	defaultRuntime = &Runtime{}
 
-	darwinSDKPaths = []string{
-		"/usr/local/share/dotnet/shared/Microsoft.NETCore.App",
-		"$HOME/.dotnet/shared/Microsoft.NETCore.App",
-	}
+	tpaExtensions = []string{".ni.dll", ".dll", ".ni.exe", ".exe"}
 )
 
 // Runtime is the runtime data structure.
+// Every Runtime carries its own CoreCLR host handle and domain ID, or its hostfxr host context.
 type Runtime struct {
 	Params        RuntimeParams
 	delegateSetup func() error
+
+	host       C.coreclrHost
+	fxr        C.hostfxrHost
+	framework  Framework
+	helperPath string
+
+	// mu guards the lifecycle transitions, the other calls only check the state. calls counts the running
+	// calls into the runtime, Shutdown waits for them, and shutdownMu serializes the Shutdown calls.
+	mu         sync.RWMutex
+	state      State
+	calls      sync.WaitGroup
+	shutdownMu sync.Mutex
+
+	consoleMu sync.Mutex
+	console   *console
+	// executeMu serializes the ExecuteAssembly calls with their own writers, see WithStdout.
+	executeMu sync.Mutex
+
+	// objectsMu guards invokeFunction, the GoDotnet.Host.Invoke entry point used by Object.
+	objectsMu      sync.Mutex
+	invokeFunction unsafe.Pointer
+
+	// tasksMu guards the pending CallAsync tasks, by ID, and the callback that completes them.
+	tasksMu      sync.Mutex
+	tasks        map[uint64]*Task
+	lastTask     uint64
+	taskCallback *Callback
+
+	// metrics is nil unless RuntimeParams.Metrics is set, it's created when the runtime starts.
+	metrics *Metrics
+
+	// delegatesMu guards the delegate registry: the CreateDelegate function pointers by method, the methods
+	// by delegate ID and the loaded plugins. It's emptied by Shutdown.
+	delegatesMu      sync.Mutex
+	delegatePointers map[MethodKey]unsafe.Pointer
+	delegateIDs      map[int]MethodKey
+	plugins          map[*Plugin]bool
 }
 
 // RuntimeParams holds the CLR initialization parameters
 type RuntimeParams struct {
-	ExePath                     string
-	AppDomainFriendlyName       string
-	Properties                  map[string]string
+	ExePath               string
+	AppDomainFriendlyName string
+	Properties            map[string]string
+	// Deprecated: ManagedAssemblyAbsolutePath isn't used, the application directories are set
+	// with the APP_PATHS and NATIVE_DLL_SEARCH_DIRECTORIES properties.
 	ManagedAssemblyAbsolutePath string
 
+	// CLRFilesAbsolutePath sets the framework directory, skipping the framework lookup.
 	CLRFilesAbsolutePath string
+
+	// FrameworkVersion is the requested Microsoft.NETCore.App version, like "8.0.0".
+	// The highest installed release is used when it's empty.
+	FrameworkVersion string
+	// RollForward sets how FrameworkVersion is matched against the installed frameworks.
+	RollForward RollForward
+
+	// RuntimeConfigPath points to the *.runtimeconfig.json file of an application. The requested frameworks,
+	// configProperties and additionalProbingPaths are read from it. FrameworkVersion, when set, takes
+	// precedence over the Microsoft.NETCore.App reference and Properties over configProperties.
+	RuntimeConfigPath string
+	// DepsFilePath points to the *.deps.json file of an application, the trusted platform assemblies and
+	// native search directories are resolved from it, looking into the NuGet package cache too.
+	DepsFilePath string
+
+	// Backend selects how the runtime is loaded, BackendCoreCLR by default. With BackendHostFXR the
+	// hostfxr library of the install location of the selected framework is used and AppDomainFriendlyName
+	// is ignored.
+	Backend Backend
+
+	// Stdout and Stderr receive the output written to the managed Console.Out and Console.Error,
+	// the managed Console writes to the process stdout and stderr when both are nil.
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// Logger receives the host diagnostics, like libraries that fail to load or failed HRESULTs.
+	// Nothing is logged when it's nil.
+	Logger Logger
+
+	// Metrics enables the call metrics, see Runtime.Metrics. The delegate calls aren't measured otherwise.
+	Metrics bool
+
+	// Delegates are created with CreateDelegate once the runtime is initialized, in order.
+	Delegates []DelegateBinding
+}
+
+// ExecuteOption customizes a single ExecuteAssembly call.
+type ExecuteOption func(*executeOptions)
+
+type executeOptions struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// WithStdout sends the managed Console.Out output to w while the assembly runs.
+// Output written by other managed threads during the call goes to w too. The managed Console is
+// shared by the whole runtime, so the ExecuteAssembly calls using WithStdout or WithStderr run one
+// at a time.
+func WithStdout(w io.Writer) ExecuteOption {
+	return func(o *executeOptions) {
+		o.stdout = w
+	}
+}
+
+// WithStderr sends the managed Console.Error output to w while the assembly runs.
+func WithStderr(w io.Writer) ExecuteOption {
+	return func(o *executeOptions) {
+		o.stderr = w
+	}
+}
+
+// NewRuntime initializes a new runtime using the given parameters.
+func NewRuntime(params RuntimeParams) (*Runtime, error) {
+	r := &Runtime{Params: params}
+	if err := r.initialize(); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
 // SetParams sets initial runtime parameters.
+//
+// Deprecated: use NewRuntime.
 func SetParams(params RuntimeParams) {
-	runtimeInstance.Params = params
+	defaultRuntime.Params = params
 }
 
 // Init performs the runtime initialization
 // This function sets a few default values to make everything easier.
+//
+// Deprecated: use NewRuntime.
 func Init() (err error) {
-	if runtimeInstance.Params.ExePath == "" {
-		runtimeInstance.Params.ExePath, err = osext.Executable()
-	}
+	return defaultRuntime.initialize()
+}
 
-	if runtimeInstance.Params.AppDomainFriendlyName == "" {
-		runtimeInstance.Params.AppDomainFriendlyName = defaultAppDomainFriendlyName
+// initialize performs the runtime initialization and runs the delegate setup function, if any.
+// A runtime can only be initialized once, and only one runtime can be loaded per process.
+// When the initialization fails before the runtime gets loaded, it can be retried. When it fails
+// afterwards, e.g. on a RuntimeParams.Delegates method that doesn't exist, the runtime is shut down.
+func (r *Runtime) initialize() (err error) {
+	if err := r.start(); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			r.log(LevelError, "Initialization failed, shutting the runtime down", Fields{"error": err.Error(), FieldStage: "lifecycle"})
+			r.shutdown(false)
+		}
+	}()
 
-	if runtimeInstance.Params.Properties == nil {
-		runtimeInstance.Params.Properties = make(map[string]string)
+	if r.Params.Stdout != nil || r.Params.Stderr != nil {
+		if err := r.redirectConsole(OpInitialize); err != nil {
+			return err
+		}
 	}
 
-	// In case you don't set APP_PATHS/NATIVE_DLL_SEARCH_DIRECTORIES, the package assumes your assemblies are in the same directory.
-	if runtimeInstance.Params.Properties["APP_PATHS"] == "" && runtimeInstance.Params.Properties["NATIVE_DLL_SEARCH_DIRECTORIES"] == "" {
-		executableFolder, _ := osext.ExecutableFolder()
-		runtimeInstance.Params.Properties["APP_PATHS"] = executableFolder
-		runtimeInstance.Params.Properties["NATIVE_DLL_SEARCH_DIRECTORIES"] = executableFolder
+	for _, b := range r.Params.Delegates {
+		if err := r.CreateDelegate(b.Assembly, b.Type, b.Method, b.ID, b.Target); err != nil {
+			return fmt.Errorf("%s.%s: %w", b.Type, b.Method, err)
+		}
 	}
 
-	count := len(runtimeInstance.Params.Properties)
+	// No delegates set?
+	if r.delegateSetup == nil {
+		return nil
+	}
+	return r.delegateSetup()
+}
 
-	keys := make([]string, 0, len(runtimeInstance.Params.Properties))
-	vals := make([]string, 0, len(runtimeInstance.Params.Properties))
+// start loads the runtime, moving it from StateUninitialized to StateRunning.
+func (r *Runtime) start() (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s := r.State(); s != StateUninitialized {
+		return &StateError{Op: OpInitialize, State: s}
+	}
+	if atomic.LoadInt32(&runtimeLoaded) != 0 {
+		err = &HRESULTError{Op: OpInitialize, Code: hrHostInvalidOperation}
+		r.log(LevelError, "A runtime was already loaded in this process", Fields{FieldHRESULT: fmt.Sprintf("0x%08x", hrHostInvalidOperation), FieldStage: "lifecycle"})
+		return err
+	}
+	r.setState(StateStarting)
+	defer func() {
+		if err != nil {
+			r.setState(StateUninitialized)
+		} else {
+			atomic.StoreInt32(&runtimeLoaded, 1)
+			if r.Params.Metrics {
+				r.metrics = newMetrics()
+			}
+			r.setState(StateRunning)
+		}
+	}()
 
-	for k, v := range runtimeInstance.Params.Properties {
-		keys = append(keys, k)
-		vals = append(vals, v)
+	if r.Params.ExePath == "" {
+		r.Params.ExePath, err = osext.Executable()
 	}
 
-	exePath := C.CString(runtimeInstance.Params.ExePath)
-	appDomainFriendlyName := C.CString(runtimeInstance.Params.AppDomainFriendlyName)
-	propertyCount := C.int(count)
-	propertyKeys := C.CString(strings.Join(keys, ";"))
-	propertyValues := C.CString(strings.Join(vals, ";"))
+	if r.Params.AppDomainFriendlyName == "" {
+		r.Params.AppDomainFriendlyName = defaultAppDomainFriendlyName
+	}
 
-	var clrFilesAbsolutePath string
+	if r.Params.Properties == nil {
+		r.Params.Properties = make(map[string]string)
+	}
 
-	// clrCommonPaths holds possible SDK locations
-	clrCommonPaths := locateSDK()
+	// In case you don't set APP_PATHS/NATIVE_DLL_SEARCH_DIRECTORIES, the package assumes your assemblies are in the same directory.
+	// Applications described by a runtimeconfig.json or deps.json file get their directories from it instead.
+	usesAppConfig := r.Params.RuntimeConfigPath != "" || r.Params.DepsFilePath != ""
+	if !usesAppConfig && r.Params.Properties[appPathsProperty] == "" && r.Params.Properties[nativeSearchDirectoriesProperty] == "" {
+		executableFolder, _ := osext.ExecutableFolder()
+		r.Params.Properties[appPathsProperty] = executableFolder
+		r.Params.Properties[nativeSearchDirectoriesProperty] = executableFolder
+	}
 
-	// Test for common SDK paths, return err if they don't exist
-	if runtimeInstance.Params.CLRFilesAbsolutePath == "" {
-		for _, p := range clrCommonPaths {
-			_, err = os.Stat(p)
-			if err == nil {
-				clrFilesAbsolutePath = p
-				break
-			}
-		}
+	// The GoDotnet helper assembly is added to the trusted platform assemblies:
+	if r.helperPath, err = extractHelper(); err != nil {
+		return err
+	}
 
-		if clrFilesAbsolutePath == "" {
-			err = errors.New("No SDK found")
-			return err
-		}
+	if r.Params.Backend == BackendHostFXR {
+		err = r.initializeHostFXR()
 	} else {
-		clrFilesAbsolutePath = runtimeInstance.Params.CLRFilesAbsolutePath
+		err = r.initializeCoreCLR()
+	}
+	if err != nil {
+		return err
 	}
+	r.log(LevelInfo, "Runtime initialized", Fields{FieldPath: r.framework.Path, "version": r.framework.Version.String()})
+	return nil
+}
 
-	clrFilesAbsolutePathC := C.CString(clrFilesAbsolutePath)
+// initializeCoreCLR loads libcoreclr from the selected framework and calls coreclr_initialize.
+func (r *Runtime) initializeCoreCLR() (err error) {
+	properties, err := r.resolveProperties()
+	if err != nil {
+		r.log(LevelError, err.Error(), Fields{FieldStage: "resolve"})
+		return err
+	}
+	assemblies := mergeAssemblies(filepath.SplitList(properties[tpaProperty]), []string{r.helperPath})
+	properties[tpaProperty] = strings.Join(assemblies, string(os.PathListSeparator))
+	clrFilesAbsolutePath := r.framework.Path
 
-	managedAssemblyAbsolutePath := C.CString(runtimeInstance.Params.ManagedAssemblyAbsolutePath)
+	// Keys are sorted so that the runtime always receives the properties in the same order:
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	vals := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals = append(vals, properties[k])
+	}
+
+	exePath := C.CString(r.Params.ExePath)
+	appDomainFriendlyName := C.CString(r.Params.AppDomainFriendlyName)
+	propertyCount := C.int(len(keys))
+	propertyKeys := newCStringArray(keys)
+	propertyValues := newCStringArray(vals)
+
+	clrFilesAbsolutePathC := C.CString(clrFilesAbsolutePath)
 
 	// Call the binding
+	r.log(LevelDebug, "Loading libcoreclr", Fields{FieldPath: clrFilesAbsolutePath, "properties": len(keys)})
 	var result C.int
-	result = C.initializeCoreCLR(exePath, appDomainFriendlyName, propertyCount, propertyKeys, propertyValues, managedAssemblyAbsolutePath, clrFilesAbsolutePathC)
+	result = C.initializeCoreCLR(&r.host, exePath, appDomainFriendlyName, propertyCount, propertyKeys, propertyValues, clrFilesAbsolutePathC)
 
-	if result == -1 {
-		err = errors.New("Runtime error")
+	if result < 0 {
+		err = newHRESULTError(OpInitialize, int32(result))
+		r.logHostError(&r.host.error, err, Fields{FieldPath: clrFilesAbsolutePath})
 	}
 
 	C.free(unsafe.Pointer(exePath))
 	C.free(unsafe.Pointer(appDomainFriendlyName))
-	C.free(unsafe.Pointer(propertyKeys))
-	C.free(unsafe.Pointer(propertyValues))
-	C.free(unsafe.Pointer(managedAssemblyAbsolutePath))
+	freeCStringArray(propertyKeys, len(keys))
+	freeCStringArray(propertyValues, len(vals))
 	C.free(unsafe.Pointer(clrFilesAbsolutePathC))
-
-	// No delegates set?
-	if runtimeInstance.delegateSetup == nil {
-		return nil
-	}
-	return runtimeInstance.delegateSetup()
+	return err
 }
 
-// locateSDK finds the SDK path
-// TODO: allow the user to use a specific version when multiple SDKs are present.
-func locateSDK() (sdkDirectories []string) {
-	var basePaths []string
-	switch runtime.GOOS {
-	case "darwin":
-		basePaths = darwinSDKPaths
-		break
-	case "linux":
-		basePaths = linuxSDKPaths
-	}
-	// Replace HOME env var from base paths:
-	homeEnv := os.Getenv("HOME")
+// tpaList builds the TRUSTED_PLATFORM_ASSEMBLIES value for the given directory.
+func tpaList(directory string) string {
+	return strings.Join(tpaAssemblies(directory), string(os.PathListSeparator))
+}
 
-	for _, basePath := range basePaths {
-		basePath = strings.Replace(basePath, "$HOME", homeEnv, 1)
-		directories, err := ioutil.ReadDir(basePath)
+// tpaAssemblies lists the assemblies found in the given directories, the first directory wins
+// when an assembly is found twice. Native images are probed first so that they're preferred if
+// ni and il files coexist.
+func tpaAssemblies(directories ...string) []string {
+	var (
+		assemblies []string
+		added      = make(map[string]bool)
+	)
+	for _, directory := range directories {
+		entries, err := ioutil.ReadDir(directory)
 		if err != nil {
 			continue
 		}
-		for _, d := range directories {
-			fullPath := filepath.Join(basePath, d.Name())
-			sdkDirectories = append(sdkDirectories, fullPath)
+		for _, ext := range tpaExtensions {
+			for _, entry := range entries {
+				name := entry.Name()
+				if entry.IsDir() || !strings.HasSuffix(name, ext) || len(name) == len(ext) {
+					continue
+				}
+				// Make sure that only one version of an assembly with multiple extensions gets in:
+				nameWithoutExt := strings.TrimSuffix(strings.TrimSuffix(name, ext), ".ni")
+				if added[nameWithoutExt] {
+					continue
+				}
+				added[nameWithoutExt] = true
+				assemblies = append(assemblies, filepath.Join(directory, name))
+			}
 		}
 	}
-	return sdkDirectories
+	return assemblies
+}
+
+// Framework returns the shared framework selected during the initialization.
+// The version is empty when CLRFilesAbsolutePath isn't named after a version.
+func (r *Runtime) Framework() Framework {
+	return r.framework
 }
 
 // Shutdown unloads the current app
 //
 //	https://github.com/dotnet/coreclr/blob/d81d773312dcae24d0b5d56cb972bf71e22f856c/src/dlls/mscoree/unixinterface.cpp#L281
 //
-func (r *Runtime) Shutdown() (err error) {
+// With BackendHostFXR the host context is closed, the runtime itself can't be unloaded.
+//
+// Shutdown waits for the running calls, like ExecuteAssembly or a Delegate call, the calls made meanwhile return
+// a StateError. It must not be called by managed code, through a Callback, as it would wait for itself.
+//
+// Shutdown is idempotent: calling it again, or on a runtime that was never initialized, returns nil.
+// The runtime can't be initialized again afterwards.
+func (r *Runtime) Shutdown() error {
+	_, err := r.shutdown(false)
+	return err
+}
+
+// ShutdownWithExitCode works like Shutdown and returns the latest exit code set by managed code
+// with Environment.ExitCode, so it can be used as the exit code of the Go process.
+// It calls coreclr_shutdown_2 when the runtime exports it, otherwise the exit code is read before
+// calling coreclr_shutdown. With BackendHostFXR it's read before closing the host context.
+//
+// The exit code is 0 when the runtime wasn't running.
+func (r *Runtime) ShutdownWithExitCode() (int, error) {
+	return r.shutdown(true)
+}
+
+func (r *Runtime) shutdown(withExitCode bool) (exitCode int, err error) {
+	// The later calls wait for the first one and find the runtime stopped.
+	r.shutdownMu.Lock()
+	defer r.shutdownMu.Unlock()
+	r.mu.Lock()
+	switch r.State() {
+	case StateUninitialized, StateStopped:
+		r.setState(StateStopped)
+		r.mu.Unlock()
+		return 0, nil
+	}
+	r.setState(StateShuttingDown)
+	r.mu.Unlock()
+	// The running calls complete before the runtime is torn down, the new ones fail, see checkRunning.
+	r.calls.Wait()
+	// r.mu isn't held, Plugin.Unload and Reload call into the runtime with the plugin locked:
+	r.unloadPlugins()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if withExitCode && (r.Params.Backend == BackendHostFXR || r.host.shutdownCoreCLR2 == nil) {
+		exitCode, err = r.managedExitCode()
+		if err != nil {
+			r.log(LevelWarn, "Can't read the managed exit code", Fields{"error": err.Error()})
+		}
+	}
+	// The tasks that didn't complete never will, and the delegates and callbacks can't be called anymore.
+	defer r.abandonTasks()
+	defer r.closeCallbacks()
+	defer r.clearDelegates()
+	if r.metrics != nil {
+		defer r.metrics.releaseAll()
+	}
+	defer r.setState(StateStopped)
+
 	var result C.int
-	result = C.shutdownCoreCLR()
+	if r.Params.Backend == BackendHostFXR {
+		result = C.closeHostFXR(&r.fxr)
+	} else {
+		var latchedExitCode C.int
+		result = C.shutdownCoreCLR(&r.host, &latchedExitCode)
+		if r.host.shutdownCoreCLR2 != nil {
+			exitCode = int(latchedExitCode)
+		}
+	}
 
-	if result == -1 {
-		err = errors.New("Shutdown error")
+	if result < 0 {
+		err = newHRESULTError(OpShutdown, int32(result))
+		r.logHostError(r.hostError(), err, nil)
+		return 0, err
 	}
 
-	return err
+	return exitCode, nil
 }
 
-// CreateDelegate wraps a cgo call to coreclr_create_delegate, receives a function pointer.
+// ExecuteAssembly runs the entry point of the given managed assembly, passing args as its argv.
+// The returned exit code is the value returned by the managed Main method.
+// WithStdout and WithStderr redirect the managed Console output of this call.
+//
+//	https://github.com/dotnet/coreclr/blob/d81d773312dcae24d0b5d56cb972bf71e22f856c/src/dlls/mscoree/unixinterface.cpp#L333
+func (r *Runtime) ExecuteAssembly(path string, args []string, options ...ExecuteOption) (exitCode int, err error) {
+	release, err := r.checkRunning(OpExecuteAssembly)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+	var o executeOptions
+	for _, option := range options {
+		option(&o)
+	}
+	if o.stdout != nil || o.stderr != nil {
+		if err := r.redirectConsole(OpExecuteAssembly); err != nil {
+			return 0, err
+		}
+		// The writers are restored before the next call swaps them:
+		r.executeMu.Lock()
+		defer r.executeMu.Unlock()
+		defer r.console.swap(o.stdout, o.stderr)()
+	}
+
+	assemblyPath := C.CString(path)
+	defer C.free(unsafe.Pointer(assemblyPath))
+
+	argv := newCStringArray(args)
+	defer freeCStringArray(argv, len(args))
+
+	r.log(LevelInfo, "Executing assembly", Fields{FieldPath: path})
+	var (
+		code   C.uint
+		result C.int
+	)
+	if r.Params.Backend == BackendHostFXR {
+		result = C.executeAssemblyHostFXR(&r.fxr, assemblyPath, C.int(len(args)), argv, &code)
+	} else {
+		result = C.executeManagedAssembly(&r.host, assemblyPath, C.int(len(args)), argv, &code)
+	}
+	if result < 0 {
+		err = newHRESULTError(OpExecuteAssembly, int32(result))
+		r.logHostError(r.hostError(), err, Fields{FieldPath: path})
+		return 0, err
+	}
+
+	// Main may return negative values, coreclr hands them back as unsigned:
+	return int(int32(code)), nil
+}
+
+// CreateDelegate wraps a cgo call to coreclr_create_delegate using the default runtime.
+//
+// Deprecated: use NewRuntime and (*Runtime).CreateDelegate.
 func CreateDelegate(assembly string, typ string, method string, delegate int, f *unsafe.Pointer) error {
+	return defaultRuntime.CreateDelegate(assembly, typ, method, delegate, f)
+}
+
+// CreateDelegate wraps a cgo call to coreclr_create_delegate, receives a function pointer.
+// With BackendHostFXR the GoDotnet helper builds a delegate matching the method signature instead,
+// methods marked with [UnmanagedCallersOnly] are returned as they are.
+//
+// The function pointers are cached by method, binding the same method again returns the same pointer.
+// A delegate ID other than 0 registers the method under it, see LookupDelegate, and returns ErrDelegateIDInUse
+// when the ID was registered for another method. The pointers can't be called after Shutdown.
+func (r *Runtime) CreateDelegate(assembly string, typ string, method string, delegate int, f *unsafe.Pointer) error {
+	release, err := r.checkRunning(OpCreateDelegate)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if f == nil {
+		return &HRESULTError{Op: OpCreateDelegate, Code: hrPointer}
+	}
+	key := MethodKey{Assembly: assembly, Type: typ, Method: method}
+	r.delegatesMu.Lock()
+	defer r.delegatesMu.Unlock()
+	if registered, ok := r.delegateIDs[delegate]; ok && registered != key {
+		return fmt.Errorf("%w: %d is %s.%s", ErrDelegateIDInUse, delegate, registered.Type, registered.Method)
+	}
+	if pointer, ok := r.delegatePointers[key]; ok {
+		*f = pointer
+	} else {
+		if err := r.createDelegate(assembly, typ, method, f); err != nil {
+			return err
+		}
+		if r.delegatePointers == nil {
+			r.delegatePointers = make(map[MethodKey]unsafe.Pointer)
+		}
+		r.delegatePointers[key] = *f
+		if r.metrics != nil {
+			r.metrics.bind(key)
+		}
+	}
+	if delegate != 0 {
+		if r.delegateIDs == nil {
+			r.delegateIDs = make(map[int]MethodKey)
+		}
+		r.delegateIDs[delegate] = key
+	}
+	return nil
+}
+
+// createDelegate is CreateDelegate without the state check and the registry, it's used by the lifecycle transitions.
+func (r *Runtime) createDelegate(assembly string, typ string, method string, f *unsafe.Pointer) error {
 	assemblyName := C.CString(assembly)
 	typeName := C.CString(typ)
 	methodName := C.CString(method)
-	delegateID := C.int(delegate)
-	result := C.createDelegate(assemblyName, typeName, methodName, delegateID, f)
-	code := uint32(result)
-	switch code {
-	case assemblyNotFound:
-		return errAssemblyNotFound
-	case typeLoadException:
-		return errTypeLoadException
-	case missingMethodException:
-		return errMissingMethodException
-	case nullReferenceException:
-		return errNullReferenceException
+	var result C.int
+	if r.Params.Backend == BackendHostFXR {
+		result = C.createDelegateHostFXR(&r.fxr, assemblyName, typeName, methodName, f)
+	} else {
+		result = C.createDelegate(&r.host, assemblyName, typeName, methodName, f)
+	}
+	C.free(unsafe.Pointer(assemblyName))
+	C.free(unsafe.Pointer(typeName))
+	C.free(unsafe.Pointer(methodName))
+	if result < 0 {
+		err := newHRESULTError(OpCreateDelegate, int32(result))
+		r.logHostError(r.hostError(), err, Fields{"assembly": assembly, "type": typ, "method": method})
+		return err
 	}
 	return nil
 }
 
 // SetupDelegates sets all create_delegate calls to be executed after the runtime initialization.
+//
+// Deprecated: use NewRuntime with RuntimeParams.Delegates, or call (*Runtime).CreateDelegate once it returns.
 func SetupDelegates(f func() error) {
-	runtimeInstance.delegateSetup = f
+	defaultRuntime.delegateSetup = f
+}
+
+// newCStringArray copies values into a C allocated char* array, it must be released with freeCStringArray.
+func newCStringArray(values []string) **C.char {
+	if len(values) == 0 {
+		return nil
+	}
+	ptrSize := unsafe.Sizeof((*C.char)(nil))
+	array := (**C.char)(C.malloc(C.size_t(len(values)) * C.size_t(ptrSize)))
+	entries := (*[1 << 28]*C.char)(unsafe.Pointer(array))[:len(values):len(values)]
+	for i, v := range values {
+		entries[i] = C.CString(v)
+	}
+	return array
+}
+
+// freeCStringArray releases an array allocated by newCStringArray.
+func freeCStringArray(array **C.char, count int) {
+	if array == nil {
+		return
+	}
+	entries := (*[1 << 28]*C.char)(unsafe.Pointer(array))[:count:count]
+	for _, v := range entries {
+		C.free(unsafe.Pointer(v))
+	}
+	C.free(unsafe.Pointer(array))
 }
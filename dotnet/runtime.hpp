@@ -7,34 +7,57 @@
 #define SUCCEEDED(Status) ((Status) >= 0)
 #endif // !SUCCEEDED
 
-void* hostHandle;
-unsigned int domainId;
+// HRESULTs reported when libcoreclr can't be loaded or doesn't export a hosting function:
+#define COR_E_ENTRYPOINTNOTFOUND ((int)0x80131523)
+#define COR_E_DLLNOTFOUND ((int)0x80131524)
+// HOST_E_INVALIDOPERATION is returned when a hosting function is called before the runtime was loaded:
+#define HOST_E_INVALIDOPERATION ((int)0x80131022)
 
-void* coreclrLib;
-coreclr_initialize_ptr initialize_core_clr;
-coreclr_execute_assembly_ptr execute_assembly;
-coreclr_shutdown_ptr shutdown_core_clr;
-coreclr_create_delegate_ptr create_delegate;
+extern "C" {
+#endif
 
-static const char* serverGcVar = "CORECLR_SERVER_GC";
-const char* useServerGc;
+#define HOST_ERROR_SIZE 512
 
-extern "C" {
+// hostError describes the last failure of a hosting call: the stage that failed, like dlopen
+// or coreclr_initialize, and a message. It's logged from Go instead of being printed.
+typedef struct hostError {
+  const char* stage;
+  char message[HOST_ERROR_SIZE];
+} hostError;
+
+#ifdef __cplusplus
+void setHostError(hostError* error, const char* stage, const char* format, ...);
 #endif
 
-int initializeCoreCLR(const char* exePath,
+// coreclrHost holds the state of a single CoreCLR instance: the loaded library,
+// the resolved hosting functions and the handle/domain pair returned by coreclr_initialize.
+// Function pointers are kept as void* so that this struct is visible from cgo.
+typedef struct coreclrHost {
+  void* coreclrLib;
+  void* hostHandle;
+  unsigned int domainId;
+
+  void* initializeCoreCLR;
+  void* executeAssembly;
+  void* shutdownCoreCLR;
+  // shutdownCoreCLR2 is only exported by .NET Core 2.0 and later, it's NULL otherwise.
+  void* shutdownCoreCLR2;
+  void* createDelegate;
+
+  hostError error;
+} coreclrHost;
+
+int initializeCoreCLR(coreclrHost* host,
+            const char* exePath,
             const char* appDomainFriendlyName,
             int propertyCount,
-            const char* mergedPropertyKeys,
-            const char* mergedPropertyValues,
-            const char* managedAssemblyAbsolutePath,
+            const char** propertyKeys,
+            const char** propertyValues,
             const char* clrFilesAbsolutePath);
-int shutdownCoreCLR();
-int executeManagedAssembly(const char*);
-            
-int createDelegate(const char* entryPointAssemblyName, const char* entryPointTypeName, const char* entryPointMethodName, int delegateID, void** f);
+int shutdownCoreCLR(coreclrHost* host, int* exitCode);
+int executeManagedAssembly(coreclrHost* host, const char* assembly, int argc, const char** argv, unsigned int* exitCode);
 
-void parseValues(const char*, char**, int);
+int createDelegate(coreclrHost* host, const char* entryPointAssemblyName, const char* entryPointTypeName, const char* entryPointMethodName, void** f);
 #ifdef __cplusplus
 }
 #endif
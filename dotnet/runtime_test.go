@@ -1,73 +1,512 @@
 package dotnet
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/matiasinsaurralde/go-dotnet/dotnet/internal/testhelper"
 )
 
 var (
 	packagePath  string
 	assemblyPath string
+
+	testRuntime *Runtime
+
+	// testStdout and testStderr receive the managed Console output of the test runtime.
+	testStdout = &syncBuffer{}
+	testStderr = &syncBuffer{}
 )
 
+// syncBuffer is a bytes.Buffer that can be written by several managed threads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// Reset returns the buffer contents and empties it.
+func (b *syncBuffer) Reset() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := b.buf.String()
+	b.buf.Reset()
+	return s
+}
+
 func init() {
 	fmt.Println("init")
 	_, filename, _, _ := runtime.Caller(0)
 	packagePath = filepath.Dir(filename)
 	assemblyPath = filepath.Join(packagePath, "testfiles")
 	// copyTestAssemblies()
-	SetParams(RuntimeParams{
+	if os.Getenv(testInitFailureVariable) != "" {
+		// TestInitFailure loads the runtime itself.
+		return
+	}
+	var err error
+	testRuntime, err = NewRuntime(testParams())
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Delegate IDs of the test runtime delegates.
+const (
+	testAddID = iota + 1
+	testStringID
+	testPrintID
+	testGetDataID
+)
+
+// testBackendVariable selects the backend of the test runtime, see TestHostFXRBackend.
+const testBackendVariable = "GO_DOTNET_TEST_BACKEND"
+
+// testParams returns the parameters used by the test runtime.
+func testParams() RuntimeParams {
+	backend := BackendCoreCLR
+	if os.Getenv(testBackendVariable) == BackendHostFXR.String() {
+		backend = BackendHostFXR
+	}
+	return RuntimeParams{
+		Backend: backend,
+		Stdout:  testStdout,
+		Stderr:  testStderr,
+		Logger:  testLogger,
+		Metrics: os.Getenv(testMetricsVariable) != "",
+		Delegates: []DelegateBinding{
+			{ID: testAddID, Assembly: "Test", Type: "Test.TestClass", Method: "Add", Target: testhelper.GetAddFunc()},
+			{ID: testStringID, Assembly: "Test", Type: "Test.TestClass", Method: "String", Target: testhelper.GetStringFunc()},
+			{ID: testPrintID, Assembly: "Test", Type: "Test.TestClass", Method: "Print", Target: testhelper.GetPrintFunc()},
+			{ID: testGetDataID, Assembly: "Test", Type: "Test.TestClass", Method: "GetData", Target: testhelper.GetGetDataFunc()},
+		},
 		Properties: map[string]string{
-			"APP_PATHS":                     assemblyPath,
-			"NATIVE_DLL_SEARCH_DIRECTORIES": assemblyPath,
+			"APP_PATHS":                      assemblyPath,
+			"NATIVE_DLL_SEARCH_DIRECTORIES":  assemblyPath,
+			"System.Globalization.Invariant": "true",
+			"GO_DOTNET_EMPTY":                "",
+			"GO_DOTNET_SEMICOLONS":           "a;b;;c",
 		},
+	}
+}
+
+func TestCreateDelegate(t *testing.T) {
+	f := testhelper.GetDummyFunc()
+	err := testRuntime.CreateDelegate("foo", "foo.foo", "foo", 0, f)
+	if !errors.Is(err, ErrAssemblyNotFound) {
+		t.Fatalf("Got %v", err)
+	}
+	err = testRuntime.CreateDelegate("Test", "foo.foo", "foo", 0, f)
+	if !errors.Is(err, ErrTypeLoadException) {
+		t.Fatalf("Got %v", err)
+	}
+	err = testRuntime.CreateDelegate("Test", "Test.TestClass", "foo", 0, f)
+	if !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+	err = testRuntime.CreateDelegate("Test", "Test.TestClass", "Add", 0, nil)
+	if !errors.Is(err, ErrNullReferenceException) {
+		t.Fatalf("Got %v", err)
+	}
+	var hresultErr *HRESULTError
+	if !errors.As(err, &hresultErr) {
+		t.Fatalf("Expected an HRESULTError, got %T", err)
+	}
+	if hresultErr.Op != OpCreateDelegate || hresultErr.Name() != "E_POINTER" {
+		t.Fatalf("Got op %q and name %q", hresultErr.Op, hresultErr.Name())
+	}
+
+	// The hostfxr backend records the failed helper call:
+	testLogger.record(t)
+	testRuntime.CreateDelegate("Test", "Test.TestClass", "foo", 0, f)
+	var entries []logEntry
+	for _, e := range testLogger.entries() {
+		if e.fields["method"] == "foo" {
+			entries = append(entries, e)
+		}
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Got %v", entries)
+	}
+	if testRuntime.Params.Backend == BackendHostFXR && entries[0].fields[FieldStage] != "helper" {
+		t.Fatalf("Got %v", entries)
+	}
+}
+
+// logEntry is a log entry recorded by a test logger.
+type logEntry struct {
+	level  Level
+	msg    string
+	fields Fields
+}
+
+// testLogger is the Logger of the test runtime. The runtime logs from other goroutines too, the tests
+// record its entries instead of replacing RuntimeParams.Logger.
+var testLogger = &recordingLogger{}
+
+// recordingLogger keeps the entries logged while a test records them.
+type recordingLogger struct {
+	mu        sync.Mutex
+	recording bool
+	logged    []logEntry
+}
+
+func (l *recordingLogger) Log(level Level, msg string, fields Fields) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.recording {
+		l.logged = append(l.logged, logEntry{level, msg, fields})
+	}
+}
+
+// record keeps the entries logged until the test ends.
+func (l *recordingLogger) record(t *testing.T) {
+	l.mu.Lock()
+	l.recording, l.logged = true, nil
+	l.mu.Unlock()
+	t.Cleanup(func() {
+		l.mu.Lock()
+		l.recording, l.logged = false, nil
+		l.mu.Unlock()
+	})
+}
+
+// entries returns the entries recorded so far.
+func (l *recordingLogger) entries() []logEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]logEntry(nil), l.logged...)
+}
+
+func TestInitTwice(t *testing.T) {
+	var entries []logEntry
+	params := testParams()
+	params.Logger = LoggerFunc(func(level Level, msg string, fields Fields) {
+		entries = append(entries, logEntry{level, msg, fields})
 	})
-	err := Init()
+	_, err := NewRuntime(params)
+	if !errors.Is(err, ErrRuntimeAlreadyInitialized) {
+		t.Fatalf("Got %v", err)
+	}
+	var logged *logEntry
+	for i := range entries {
+		if entries[i].level == LevelError {
+			logged = &entries[i]
+		}
+	}
+	if logged == nil {
+		t.Fatalf("The failure wasn't logged: %v", entries)
+	}
+	if logged.fields[FieldHRESULT] != "0x80131022" || logged.fields[FieldBackend] != params.Backend.String() {
+		t.Fatalf("Unexpected fields %v", logged.fields)
+	}
+	if logged.fields[FieldStage] != "lifecycle" {
+		t.Fatalf("Unexpected stage %v", logged.fields[FieldStage])
+	}
+	if errors.Is(err, &HRESULTError{Op: OpShutdown, Code: hrHostInvalidOperation}) {
+		t.Fatal("Expected the operation to be compared")
+	}
+}
+
+// testInitFailureVariable makes TestInitFailure initialize the runtime, instead of init.
+const testInitFailureVariable = "GO_DOTNET_TEST_INIT_FAILURE"
+
+func TestInitFailure(t *testing.T) {
+	if os.Getenv(testInitFailureVariable) == "" {
+		output := runTestProcess(t, testInitFailureVariable, "1", "^TestInitFailure$")
+		if !strings.Contains(output, "--- PASS: TestInitFailure") {
+			t.Fatalf("TestInitFailure didn't run:\n%s", output)
+		}
+		return
+	}
+	var missing unsafe.Pointer
+	params := testParams()
+	params.Delegates = append(params.Delegates, DelegateBinding{Assembly: "Test", Type: "Test.TestClass", Method: "Missing", Target: &missing})
+	r := &Runtime{Params: params}
+	if err := r.initialize(); !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+	// The runtime was loaded before the delegates were created, it's shut down:
+	if s := r.State(); s != StateStopped {
+		t.Fatalf("Got state %v", s)
+	}
+	if _, ok := r.LookupDelegate(testAddID); ok {
+		t.Fatal("The delegates are still registered")
+	}
+	if _, err := NewRuntime(testParams()); !errors.Is(err, ErrRuntimeAlreadyInitialized) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+// runTestProcess runs the tests matching pattern in a new process, with the environment variable set.
+// Only one runtime can be loaded per process, tests that need a different one run this way.
+func runTestProcess(t *testing.T, variable, value, pattern string) string {
+	cmd := exec.Command(os.Args[0], "-test.run", pattern, "-test.v")
+	cmd.Env = append(os.Environ(), variable+"="+value)
+	output, err := cmd.CombinedOutput()
 	if err != nil {
-		panic(err)
+		t.Fatalf("%s=%s tests failed: %v\n%s", variable, value, err, output)
+	}
+	return string(output)
+}
+
+// TestHostFXRBackend runs the whole test suite again in a child process using the hostfxr backend, since a
+// process can only load the runtime once. The tests check testRuntime.Params.Backend for the backend specifics.
+func TestHostFXRBackend(t *testing.T) {
+	if testRuntime.Params.Backend == BackendHostFXR {
+		t.Skip("Already running on the hostfxr backend")
+	}
+	output := runTestProcess(t, testBackendVariable, BackendHostFXR.String(), ".")
+	if !strings.Contains(output, "--- PASS: TestLoadComponent") {
+		t.Fatalf("hostfxr backend tests didn't run:\n%s", output)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	if s := testRuntime.State(); s != StateRunning {
+		t.Fatalf("Got state %v", s)
+	}
+	err := testRuntime.initialize()
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) || stateErr.Op != OpInitialize || stateErr.State != StateRunning {
+		t.Fatalf("Got %v", err)
+	}
+
+	r := &Runtime{Params: testParams()}
+	var f unsafe.Pointer
+	err = r.CreateDelegate("Test", "Test.TestClass", "Add", 0, &f)
+	if !errors.Is(err, ErrInvalidState) || f != nil {
+		t.Fatalf("Got %v", err)
+	}
+	_, err = r.ExecuteAssembly(filepath.Join(assemblyPath, "Exec.dll"), nil)
+	if !errors.As(err, &stateErr) || stateErr.Op != OpExecuteAssembly || stateErr.State != StateUninitialized {
+		t.Fatalf("Got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := r.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
 	}
-	addFunc := getAddFunc()
-	err = CreateDelegate("Test", "Test.TestClass", "Add", 0, addFunc)
+	if s := r.State(); s != StateStopped {
+		t.Fatalf("Got state %v", s)
+	}
+	if err := r.initialize(); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+// testShutdownVariable makes TestShutdown shut the test runtime down, see TestShutdown.
+const testShutdownVariable = "GO_DOTNET_TEST_SHUTDOWN"
+
+func TestShutdown(t *testing.T) {
+	if os.Getenv(testShutdownVariable) == "" {
+		output := runTestProcess(t, testShutdownVariable, "1", "^TestShutdown$")
+		if !strings.Contains(output, "--- PASS: TestShutdown") {
+			t.Fatalf("Shutdown test didn't run:\n%s", output)
+		}
+		return
+	}
+	if err := testRuntime.CreateDelegate("Test", "Test.TestClass", "SetExitCode", 0, testhelper.GetSetExitCodeFunc()); err != nil {
+		t.Fatal(err)
+	}
+	testhelper.CallSetExitCodeFunc(3)
+	add, err := Bind[func(int32, int32) (int32, error)](testRuntime, "Test", "Test.TestClass", "Add")
 	if err != nil {
-		panic(err)
+		t.Fatal(err)
 	}
-	stringFunc := getStringFunc()
-	err = CreateDelegate("Test", "Test.TestClass", "String", 0, stringFunc)
+	divide, err := testRuntime.NewDelegate("Test", "Test.TestClass", "Divide", "int32(int32,int32)")
 	if err != nil {
-		panic(err)
+		t.Fatal(err)
+	}
+	plugin, err := testRuntime.LoadPlugin(writeFile(t, tempDir(t, "shutdown"), "Plugin.dll", readTestFile(t, "testfiles/plugin/v1/Plugin.dll")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forever, err := testRuntime.CallAsync(context.Background(), "Test", "Test.AsyncClass", "Forever")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// late completes after Shutdown, once its callback is closed: the managed side releases the result.
+	late, err := testRuntime.CallAsync(context.Background(), "Test", "Test.AsyncClass", "AddLater", 1, 2, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Shutdown waits for the running calls, the calls made meanwhile fail:
+	entered, proceed := make(chan struct{}), make(chan struct{})
+	var nested error
+	callback, err := testRuntime.NewCallback(func(a, b int32) int32 {
+		close(entered)
+		<-proceed
+		_, nested = testRuntime.New("Test", "Test.Counter")
+		return a + b
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter, err := testRuntime.New("Test", "Test.Counter", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied := make(chan error, 1)
+	go func() {
+		v, err := counter.Call("Apply", int64(uintptr(callback.Pointer())), 2)
+		if err == nil && v != int32(7) {
+			err = fmt.Errorf("got %v", v)
+		}
+		applied <- err
+	}()
+	<-entered
+	type shutdownResult struct {
+		exitCode int
+		err      error
+	}
+	shutdown := make(chan shutdownResult, 1)
+	go func() {
+		exitCode, err := testRuntime.ShutdownWithExitCode()
+		shutdown <- shutdownResult{exitCode, err}
+	}()
+	for testRuntime.State() != StateShuttingDown {
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case <-shutdown:
+		t.Fatal("Shutdown didn't wait for the running call")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(proceed)
+	if err := <-applied; err != nil {
+		t.Fatal(err)
+	}
+	var stateErr *StateError
+	if !errors.As(nested, &stateErr) || stateErr.State != StateShuttingDown {
+		t.Fatalf("Got %v", nested)
+	}
+	result := <-shutdown
+	if exitCode, err := result.exitCode, result.err; err != nil || exitCode != 3 {
+		t.Fatalf("Got %d, %v", exitCode, err)
+	}
+	if s := testRuntime.State(); s != StateStopped {
+		t.Fatalf("Got state %v", s)
+	}
+	var f unsafe.Pointer
+	err = testRuntime.CreateDelegate("Test", "Test.TestClass", "Add", 0, &f)
+	if !errors.As(err, &stateErr) || stateErr.State != StateStopped {
+		t.Fatalf("Got %v", err)
+	}
+	// The state is checked before the backend:
+	_, err = testRuntime.LoadComponent("Test.dll", "Test.TestClass, Test", "Component", "")
+	if !errors.As(err, &stateErr) || stateErr.Op != OpLoadComponent || stateErr.State != StateStopped {
+		t.Fatalf("Got %v", err)
+	}
+	for _, task := range []*Task{forever, late} {
+		if _, err := task.Wait(context.Background()); !errors.As(err, &stateErr) || stateErr.Op != OpTask {
+			t.Fatalf("Got %v", err)
+		}
+	}
+	callbacksMu.RLock()
+	for _, c := range callbacks {
+		if c != nil {
+			t.Fatalf("The %s callback is still registered", c.Signature())
+		}
+	}
+	callbacksMu.RUnlock()
+	// The managed late task completes meanwhile, calling its closed callback:
+	time.Sleep(300 * time.Millisecond)
+	for _, call := range []func() (interface{}, error){
+		func() (interface{}, error) { return add(1, 2) },
+		func() (interface{}, error) { return divide.Call(4, 2) },
+		func() (interface{}, error) { return calls.Call() },
+	} {
+		if _, err := call(); !errors.As(err, &stateErr) || stateErr.Op != OpCallDelegate || stateErr.State != StateStopped {
+			t.Fatalf("Got %v", err)
+		}
+	}
+	if _, err := plugin.NewDelegate("Plugin.Rules", "Calls", "int32()"); !errors.Is(err, ErrPluginUnloaded) {
+		t.Fatalf("Got %v", err)
+	}
+	if err := plugin.Unload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := testRuntime.LookupDelegate(testAddID); ok {
+		t.Fatal("The delegate is still registered")
+	}
+	if delegates := testRuntime.Delegates(); len(delegates) != 0 {
+		t.Fatalf("Got %v", delegates)
+	}
+	if exitCode, err := testRuntime.ShutdownWithExitCode(); err != nil || exitCode != 0 {
+		t.Fatalf("Second shutdown returned %d, %v", exitCode, err)
+	}
+	if _, err := NewRuntime(testParams()); !errors.Is(err, ErrRuntimeAlreadyInitialized) {
+		t.Fatalf("Got %v", err)
 	}
 }
 
-func TestCreateDelegate(t *testing.T) {
-	f := getDummyFunc()
-	err := CreateDelegate("foo", "foo.foo", "foo", 1, f)
-	if err != errAssemblyNotFound {
-		t.Fatalf("Got %s", err.Error())
+func TestLoadComponent(t *testing.T) {
+	path := filepath.Join(assemblyPath, "Test.dll")
+	f, err := testRuntime.LoadComponent(path, "Test.TestClass, Test", "Component", "")
+	if testRuntime.Params.Backend != BackendHostFXR {
+		if !errors.Is(err, ErrBackendNotSupported) {
+			t.Fatalf("Got %v", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
 	}
-	err = CreateDelegate("Test", "foo.foo", "foo", 1, f)
-	if err != errTypeLoadException {
-		t.Fatalf("Got %s", err.Error())
+	if n := testhelper.CallComponentFunc(f, 21); n != 42 {
+		t.Fatalf("Component call failed, got %d, expected %d", n, 42)
 	}
-	err = CreateDelegate("Test", "Test.TestClass", "foo", 1, f)
-	if err != errMissingMethodException {
-		t.Fatalf("Got %s", err.Error())
+	_, err = testRuntime.LoadComponent(path, "Test.TestClass, Test", "Missing", "")
+	var hresultErr *HRESULTError
+	if !errors.As(err, &hresultErr) || hresultErr.Op != OpLoadComponent {
+		t.Fatalf("Got %v", err)
 	}
-	err = CreateDelegate("Test", "Test.TestClass", "Add", 1, nil)
-	if err != errNullReferenceException {
-		t.Fatalf("Got %s", err.Error())
+}
+
+func TestConsoleOutput(t *testing.T) {
+	testStdout.Reset()
+	testStderr.Reset()
+	testhelper.CallPrintFunc("hello from Go, ñandú")
+	if got, expected := testStdout.Reset(), "hello from Go, ñandú\n"; got != expected {
+		t.Fatalf("Stdout is %q, expected %q", got, expected)
+	}
+	if got, expected := testStderr.Reset(), "error: hello from Go, ñandú\n"; got != expected {
+		t.Fatalf("Stderr is %q, expected %q", got, expected)
 	}
 }
+
 func TestAddFunc(t *testing.T) {
-	n := callAddFunc(2, 2)
+	n := testhelper.CallAddFunc(2, 2)
 	if n != 4 {
 		t.Fatalf("AddFunc call failed, got %d, expected %d", n, 4)
 	}
 }
 
 func TestStringFunc(t *testing.T) {
-	s := callStringFunc()
+	s := testhelper.CallStringFunc()
 	if s != "teststring" {
 		t.Fatalf("StringFunc call failed, got %s, expected %s", s, "teststring")
 	}
@@ -76,13 +515,140 @@ func TestStringFunc(t *testing.T) {
 func BenchmarkAddFunc(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
-		callAddFunc(2, 2)
+		testhelper.CallAddFunc(2, 2)
 	}
 }
 
+// BenchmarkStringFunc reports the C heap growth per call as leaked-B/op,
+// run it with MALLOC_ARENA_MAX=1 for an accurate value, see testhelper.HeapInUse.
 func BenchmarkStringFunc(b *testing.B) {
 	b.ReportAllocs()
+	testhelper.CallStringFunc()
+	before := testhelper.HeapInUse()
 	for i := 0; i < b.N; i++ {
-		callStringFunc()
+		testhelper.CallStringFunc()
+	}
+	if before >= 0 {
+		b.ReportMetric(float64(testhelper.HeapInUse()-before)/float64(b.N), "leaked-B/op")
+	}
+}
+
+func TestProperties(t *testing.T) {
+	expected := map[string]string{
+		"APP_PATHS":            assemblyPath,
+		"GO_DOTNET_EMPTY":      "",
+		"GO_DOTNET_SEMICOLONS": "a;b;;c",
+	}
+	for name, value := range expected {
+		if got := testhelper.CallGetDataFunc(name); got != value {
+			t.Fatalf("Property %s is %q, expected %q", name, got, value)
+		}
+	}
+	tpa := testhelper.CallGetDataFunc(tpaProperty)
+	if !strings.Contains(tpa, "System.Private.CoreLib.dll") {
+		t.Fatalf("TRUSTED_PLATFORM_ASSEMBLIES doesn't include System.Private.CoreLib.dll: %q", tpa)
+	}
+}
+
+func TestFramework(t *testing.T) {
+	f := testRuntime.Framework()
+	if f.Name != frameworkName || f.Version == (Version{}) {
+		t.Fatalf("Unexpected framework %+v", f)
+	}
+	if filepath.Base(f.Path) != f.Version.String() {
+		t.Fatalf("Framework path %s doesn't match version %s", f.Path, f.Version)
+	}
+}
+
+func TestTPAList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tpa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"a.dll", "a.ni.dll", "b.dll", "c.exe", "d.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "e.dll"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		filepath.Join(dir, "a.ni.dll"),
+		filepath.Join(dir, "b.dll"),
+		filepath.Join(dir, "c.exe"),
+	}, string(os.PathListSeparator))
+	if got := tpaList(dir); got != expected {
+		t.Fatalf("Got %q, expected %q", got, expected)
+	}
+}
+
+func TestExecuteAssembly(t *testing.T) {
+	execPath := filepath.Join(assemblyPath, "Exec.dll")
+	tests := []struct {
+		args     []string
+		exitCode int
+	}{
+		{nil, 0},
+		{[]string{"42"}, 42},
+		{[]string{"40", "with spaces", "with;semicolon"}, 42},
+		{[]string{"-1"}, -1},
+	}
+	for _, test := range tests {
+		exitCode, err := testRuntime.ExecuteAssembly(execPath, test.args)
+		if err != nil {
+			t.Fatalf("ExecuteAssembly(%q) failed: %s", test.args, err.Error())
+		}
+		if exitCode != test.exitCode {
+			t.Fatalf("ExecuteAssembly(%q) returned %d, expected %d", test.args, exitCode, test.exitCode)
+		}
+	}
+
+	// Per call writers take precedence over RuntimeParams.Stdout:
+	testStdout.Reset()
+	var stdout bytes.Buffer
+	_, err := testRuntime.ExecuteAssembly(execPath, []string{"1", "2"}, WithStdout(&stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := stdout.String(), "args: 1,2\n"; got != expected {
+		t.Fatalf("Stdout is %q, expected %q", got, expected)
+	}
+	if got := testStdout.Reset(); got != "" {
+		t.Fatalf("Output leaked into the runtime stdout: %q", got)
+	}
+	testRuntime.ExecuteAssembly(execPath, []string{"3"})
+	if got, expected := testStdout.Reset(), "args: 3\n"; got != expected {
+		t.Fatalf("Runtime stdout wasn't restored, got %q, expected %q", got, expected)
+	}
+
+	// Concurrent calls don't see each other's output:
+	var (
+		wg      sync.WaitGroup
+		outputs [2]bytes.Buffer
+		errs    [2]error
+	)
+	for i := 0; i < 20; i++ {
+		for j := range outputs {
+			outputs[j].Reset()
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				_, errs[j] = testRuntime.ExecuteAssembly(execPath, []string{strconv.Itoa(j), "wait"}, WithStdout(&outputs[j]))
+			}(j)
+		}
+		wg.Wait()
+		for j := range outputs {
+			if errs[j] != nil {
+				t.Fatal(errs[j])
+			}
+			if got, expected := outputs[j].String(), fmt.Sprintf("args: %d,wait\ndone\n", j); got != expected {
+				t.Fatalf("Stdout is %q, expected %q", got, expected)
+			}
+		}
+	}
+	if got := testStdout.Reset(); got != "" {
+		t.Fatalf("Output leaked into the runtime stdout: %q", got)
 	}
 }
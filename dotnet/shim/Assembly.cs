@@ -0,0 +1,78 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Reflection;
+using System.Reflection.Metadata;
+using System.Reflection.PortableExecutable;
+using System.Runtime.InteropServices;
+using System.Runtime.Loader;
+
+namespace GoDotnet {
+  public static partial class Host {
+    [StructLayout(LayoutKind.Sequential)]
+    struct LoadAssemblyArgs {
+      public IntPtr Name;
+      public IntPtr Image;
+      public int ImageSize;
+      public IntPtr Symbols;
+      public int SymbolsSize;
+    }
+
+    // The assemblies loaded by LoadAssembly, by simple name.
+    static readonly Dictionary<string, Assembly> memoryAssemblies = new Dictionary<string, Assembly>(StringComparer.OrdinalIgnoreCase);
+
+    // LoadAssembly loads an assembly image, and its optional portable PDB symbols, from memory into the default load
+    // context. Name must be the simple name of the assembly. The default context doesn't find such assemblies by
+    // name, a Resolving handler returns them, so LoadFromAssemblyName and the assemblies referencing them do.
+    public static int LoadAssembly(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<LoadAssemblyArgs>(args);
+        var name = Marshal.PtrToStringUTF8(a.Name);
+        var image = Copy(a.Image, a.ImageSize);
+        var imageName = ImageName(image);
+        if (!string.Equals(imageName, name, StringComparison.OrdinalIgnoreCase)) {
+          throw new FileLoadException("The image is " + imageName + ", not " + name, name);
+        }
+        lock (memoryAssemblies) {
+          if (memoryAssemblies.ContainsKey(name)) {
+            throw new FileLoadException(name + " is already loaded", name);
+          }
+          if (memoryAssemblies.Count == 0) {
+            AssemblyLoadContext.Default.Resolving += (context, assemblyName) => MemoryAssembly(assemblyName.Name);
+          }
+          var symbols = a.Symbols == IntPtr.Zero ? null : new MemoryStream(Copy(a.Symbols, a.SymbolsSize));
+          memoryAssemblies[name] = AssemblyLoadContext.Default.LoadFromStream(new MemoryStream(image), symbols);
+        }
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    static Assembly MemoryAssembly(string name) {
+      lock (memoryAssemblies) {
+        memoryAssemblies.TryGetValue(name, out var assembly);
+        return assembly;
+      }
+    }
+
+    // ImageName returns the simple name of an assembly image, without loading it.
+    static string ImageName(byte[] image) {
+      using var pe = new PEReader(new MemoryStream(image));
+      if (!pe.HasMetadata) {
+        throw new BadImageFormatException("The image doesn't have metadata");
+      }
+      var metadata = pe.GetMetadataReader();
+      if (!metadata.IsAssembly) {
+        throw new BadImageFormatException("The image isn't an assembly");
+      }
+      return metadata.GetString(metadata.GetAssemblyDefinition().Name);
+    }
+
+    static byte[] Copy(IntPtr p, int size) {
+      var bytes = new byte[size];
+      Marshal.Copy(p, bytes, 0, size);
+      return bytes;
+    }
+  }
+}
@@ -0,0 +1,158 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using System.Reflection.Emit;
+using System.Runtime.InteropServices;
+using System.Text.Json;
+
+namespace GoDotnet {
+  public static partial class Host {
+    [StructLayout(LayoutKind.Sequential)]
+    struct BindMethodArgs {
+      public IntPtr Assembly;
+      public IntPtr Type;
+      public IntPtr Method;
+      public IntPtr Result;
+      public IntPtr Description;
+      public long Context;
+    }
+
+    // Wrappers handed out as function pointers, cached per method like delegates.
+    static readonly Dictionary<MethodInfo, Delegate> wrappers = new Dictionary<MethodInfo, Delegate>();
+
+    // BindMethod is CreateDelegate for the Delegate type of the Go package. The function pointer calls a wrapper
+    // of the method that:
+    //  - takes the structs passed by value as pointers, and stores a struct result in an extra pointer parameter,
+    //    so that callers don't depend on the platform rules for structs;
+    //  - catches the exceptions, a last extra parameter points to an IntPtr that receives them, see StoreException.
+    // [UnmanagedCallersOnly] methods are returned as they are. The method is found in the plugin Context, see
+    // LoadPlugin, or in the default context when it's 0. Result receives the function pointer and Description a JSON
+    // description of the method, a UTF-8 string allocated with AllocCoTaskMem:
+    // {"params": [struct layout or null, ...], "result": struct layout or null, "types": [type name, ...], "catches": bool},
+    // types names the parameters and the result, last, see TypeName.
+    public static int BindMethod(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<BindMethodArgs>(args);
+        var method = StaticMethod(a.Context, a.Assembly, a.Type, a.Method);
+        var catches = !IsUnmanagedCallersOnly(method);
+        if (!catches && (IsStruct(method.ReturnType) || method.GetParameters().Any(p => IsStruct(p.ParameterType)))) {
+          throw new NotSupportedException("[UnmanagedCallersOnly] methods can't take or return structs by value");
+        }
+        var description = new {
+          @params = method.GetParameters().Select(p => ParameterLayout(p.ParameterType)).ToArray(),
+          result = ParameterLayout(method.ReturnType),
+          types = method.GetParameters().Select(p => TypeName(p.ParameterType, p))
+            .Append(TypeName(method.ReturnType, method.ReturnParameter)).ToArray(),
+          catches,
+        };
+        var f = catches ? WrapperFunctionPointer(method) : FunctionPointer(method);
+        Marshal.WriteIntPtr(args, (int)Marshal.OffsetOf<BindMethodArgs>("Result"), f);
+        Marshal.WriteIntPtr(args, (int)Marshal.OffsetOf<BindMethodArgs>("Description"),
+          Marshal.StringToCoTaskMemUTF8(JsonSerializer.Serialize(description)));
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // TypeName returns the Go type name matching a parameter type: the primitive types are named like the struct
+    // fields, see fieldTypes, IntPtr is uintptr, unmanaged pointers and refs to them are *int32, *float64 and so on,
+    // the other pointers, refs and delegates are pointer and strings are string or utf16string, following their
+    // marshaling. Structs passed by value are struct, the other types are named after their full name and don't
+    // match any Go type.
+    static string TypeName(Type t, ParameterInfo p) {
+      if (t.IsEnum) {
+        t = Enum.GetUnderlyingType(t);
+      }
+      if (t == typeof(void)) {
+        return "void";
+      }
+      if (t.IsByRef || t.IsPointer) {
+        var element = t.GetElementType();
+        if (element.IsEnum) {
+          element = Enum.GetUnderlyingType(element);
+        }
+        return fieldTypes.TryGetValue(element, out var elementName) ? "*" + elementName : "pointer";
+      }
+      if (typeof(Delegate).IsAssignableFrom(t)) {
+        return "pointer";
+      }
+      if (t == typeof(string)) {
+        switch (p.GetCustomAttribute<MarshalAsAttribute>()?.Value) {
+        case null:
+        case UnmanagedType.LPStr:
+        case UnmanagedType.LPUTF8Str:
+          return "string";
+        case UnmanagedType.LPWStr:
+          return "utf16string";
+        }
+      } else if (fieldTypes.TryGetValue(t, out var name)) {
+        return name;
+      } else if (IsStruct(t)) {
+        return "struct";
+      }
+      return t.FullName;
+    }
+
+    static IntPtr WrapperFunctionPointer(MethodInfo method) {
+      lock (delegates) {
+        if (!wrappers.TryGetValue(method, out var d)) {
+          d = Wrapper(method);
+          wrappers[method] = d;
+        }
+        return Marshal.GetFunctionPointerForDelegate(d);
+      }
+    }
+
+    // Wrapper emits the wrapper described by BindMethod.
+    static Delegate Wrapper(MethodInfo method) {
+      var parameters = method.GetParameters();
+      var returnsStruct = IsStruct(method.ReturnType);
+      var types = new List<Type>();
+      var marshaling = new List<ParameterInfo>();
+      foreach (var p in parameters) {
+        var byValue = IsStruct(p.ParameterType);
+        types.Add(byValue ? typeof(IntPtr) : p.ParameterType);
+        marshaling.Add(byValue ? null : p);
+      }
+      if (returnsStruct) {
+        types.Add(typeof(IntPtr));
+      }
+      var exceptionArg = (short)types.Count;
+      types.Add(typeof(IntPtr));
+      var returnType = returnsStruct ? typeof(void) : method.ReturnType;
+
+      // The wrapper is named after the method, small methods are inlined in it and missing from the stack traces.
+      var wrapper = new DynamicMethod(method.DeclaringType.FullName + "." + method.Name, returnType, types.ToArray(), typeof(Host).Module, true);
+      var il = wrapper.GetILGenerator();
+      var result = returnType == typeof(void) ? null : il.DeclareLocal(returnType);
+      il.BeginExceptionBlock();
+      if (returnsStruct) {
+        il.Emit(OpCodes.Ldarg, (short)parameters.Length);
+      }
+      for (var i = 0; i < parameters.Length; i++) {
+        il.Emit(OpCodes.Ldarg, (short)i);
+        if (IsStruct(parameters[i].ParameterType)) {
+          il.Emit(OpCodes.Ldobj, parameters[i].ParameterType);
+        }
+      }
+      il.Emit(OpCodes.Call, method);
+      if (returnsStruct) {
+        il.Emit(OpCodes.Stobj, method.ReturnType);
+      } else if (result != null) {
+        il.Emit(OpCodes.Stloc, result);
+      }
+      il.BeginCatchBlock(typeof(Exception));
+      il.Emit(OpCodes.Ldarg, exceptionArg);
+      il.Emit(OpCodes.Call, typeof(Host).GetMethod(nameof(StoreException), StaticMethods));
+      il.EndExceptionBlock();
+      if (result != null) {
+        il.Emit(OpCodes.Ldloc, result);
+      }
+      il.Emit(OpCodes.Ret);
+      var type = DelegateType(DelegateModule(method), returnType, returnsStruct ? null : method.ReturnParameter, types.ToArray(), marshaling.ToArray());
+      return wrapper.CreateDelegate(type);
+    }
+  }
+}
@@ -0,0 +1,79 @@
+using System;
+using System.IO;
+using System.Runtime.InteropServices;
+using System.Text;
+
+namespace GoDotnet {
+  public static partial class Host {
+    const int StandardOutput = 1;
+    const int StandardError = 2;
+
+    [StructLayout(LayoutKind.Sequential)]
+    struct SetConsoleArgs {
+      public IntPtr Write;
+      public IntPtr Context;
+    }
+
+    // SetConsole redirects Console.Out and Console.Error to a native write callback,
+    // int write(IntPtr context, int stream, IntPtr data, int length), which returns a negative value on failure.
+    public static int SetConsole(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<SetConsoleArgs>(args);
+        var write = Marshal.GetDelegateForFunctionPointer<ConsoleWrite>(a.Write);
+        Console.SetOut(ConsoleWriter(new ConsoleStream(write, a.Context, StandardOutput)));
+        Console.SetError(ConsoleWriter(new ConsoleStream(write, a.Context, StandardError)));
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    static TextWriter ConsoleWriter(Stream stream) {
+      return new StreamWriter(stream, new UTF8Encoding(false), 256) { AutoFlush = true };
+    }
+  }
+
+  [UnmanagedFunctionPointer(CallingConvention.Cdecl)]
+  delegate int ConsoleWrite(IntPtr context, int stream, IntPtr data, int length);
+
+  // ConsoleStream is a write only stream that hands every write to the native callback.
+  sealed class ConsoleStream : Stream {
+    readonly ConsoleWrite write;
+    readonly IntPtr context;
+    readonly int stream;
+
+    public ConsoleStream(ConsoleWrite write, IntPtr context, int stream) {
+      this.write = write;
+      this.context = context;
+      this.stream = stream;
+    }
+
+    public override bool CanRead => false;
+    public override bool CanSeek => false;
+    public override bool CanWrite => true;
+    public override long Length => throw new NotSupportedException();
+    public override long Position {
+      get => throw new NotSupportedException();
+      set => throw new NotSupportedException();
+    }
+
+    public override void Write(byte[] buffer, int offset, int count) {
+      if (count == 0) {
+        return;
+      }
+      var handle = GCHandle.Alloc(buffer, GCHandleType.Pinned);
+      try {
+        if (write(context, stream, handle.AddrOfPinnedObject() + offset, count) < 0) {
+          throw new IOException("Console write failed");
+        }
+      } finally {
+        handle.Free();
+      }
+    }
+
+    public override void Flush() {}
+    public override int Read(byte[] buffer, int offset, int count) => throw new NotSupportedException();
+    public override long Seek(long offset, SeekOrigin origin) => throw new NotSupportedException();
+    public override void SetLength(long value) => throw new NotSupportedException();
+  }
+}
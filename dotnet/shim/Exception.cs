@@ -0,0 +1,34 @@
+using System;
+using System.Linq;
+using System.Runtime.InteropServices;
+using System.Text.Json;
+
+namespace GoDotnet {
+  public static partial class Host {
+    // StoreException stores a JSON description of e in *exception, a UTF-8 string allocated with AllocCoTaskMem:
+    // {"type", "message", "hresult", "stackTrace", "innerExceptions": [...]}.
+    // It's called by the wrappers emitted by BindMethod and must not throw.
+    static void StoreException(Exception e, IntPtr exception) {
+      if (exception == IntPtr.Zero) {
+        return;
+      }
+      try {
+        Marshal.WriteIntPtr(exception, Marshal.StringToCoTaskMemUTF8(JsonSerializer.Serialize(Describe(e))));
+      } catch {
+        // Out of memory, the caller only sees a missing exception.
+      }
+    }
+
+    static object Describe(Exception e) {
+      var inner = e is AggregateException aggregate ? aggregate.InnerExceptions.ToArray() :
+        e.InnerException != null ? new[] { e.InnerException } : new Exception[0];
+      return new {
+        type = e.GetType().FullName,
+        message = e.Message,
+        hresult = e.HResult,
+        stackTrace = e.StackTrace ?? "",
+        innerExceptions = inner.Select(Describe).ToArray(),
+      };
+    }
+  }
+}
@@ -0,0 +1,174 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Reflection;
+using System.Reflection.Emit;
+using System.Runtime.InteropServices;
+using System.Runtime.Loader;
+
+namespace GoDotnet {
+  // Host implements the hosting helpers that coreclr and hostfxr don't provide by themselves.
+  // Every entry point follows the default component entry point signature,
+  // int (IntPtr args, int sizeBytes), and returns an HRESULT.
+  public static partial class Host {
+    const int E_POINTER = unchecked((int)0x80004003);
+    const BindingFlags StaticMethods = BindingFlags.Public | BindingFlags.NonPublic | BindingFlags.Static;
+
+    [StructLayout(LayoutKind.Sequential)]
+    struct CreateDelegateArgs {
+      public IntPtr Assembly;
+      public IntPtr Type;
+      public IntPtr Method;
+      public IntPtr Result;
+    }
+
+    [StructLayout(LayoutKind.Sequential)]
+    struct ExecuteAssemblyArgs {
+      public IntPtr Path;
+      public int Argc;
+      public IntPtr Argv;
+      public IntPtr ExitCode;
+    }
+
+    // Delegates handed out as function pointers must stay alive, they're cached per method.
+    static readonly Dictionary<MethodInfo, Delegate> delegates = new Dictionary<MethodInfo, Delegate>();
+    static ModuleBuilder delegateTypes;
+    static int delegateTypeCount;
+
+    // CreateDelegate mimics coreclr_create_delegate: it returns a native callable pointer
+    // for a static method of an assembly loaded in the default load context.
+    public static int CreateDelegate(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<CreateDelegateArgs>(args);
+        if (a.Result == IntPtr.Zero) {
+          return E_POINTER;
+        }
+        Marshal.WriteIntPtr(a.Result, FunctionPointer(StaticMethod(0, a.Assembly, a.Type, a.Method)));
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // ExecuteAssembly mimics coreclr_execute_assembly: it runs the entry point of an assembly
+    // and stores the value returned by Main.
+    public static int ExecuteAssembly(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<ExecuteAssemblyArgs>(args);
+        var argv = new string[a.Argc];
+        for (var i = 0; i < argv.Length; i++) {
+          argv[i] = Marshal.PtrToStringUTF8(Marshal.ReadIntPtr(a.Argv, i * IntPtr.Size));
+        }
+        var path = Path.GetFullPath(Marshal.PtrToStringUTF8(a.Path));
+        var assembly = AssemblyLoadContext.Default.LoadFromAssemblyPath(path);
+        var main = assembly.EntryPoint;
+        if (main == null) {
+          throw new MissingMethodException(assembly.GetName().Name, "Main");
+        }
+        object result;
+        try {
+          result = main.Invoke(null, main.GetParameters().Length == 0 ? null : new object[] { argv });
+        } catch (TargetInvocationException e) {
+          Console.Error.WriteLine("Unhandled exception. " + e.InnerException);
+          return e.InnerException.HResult;
+        }
+        if (a.ExitCode != IntPtr.Zero) {
+          Marshal.WriteInt32(a.ExitCode, result is int exitCode ? exitCode : 0);
+        }
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // GetExitCode stores Environment.ExitCode in args, an int32.
+    public static int GetExitCode(IntPtr args, int sizeBytes) {
+      if (args == IntPtr.Zero) {
+        return E_POINTER;
+      }
+      Marshal.WriteInt32(args, Environment.ExitCode);
+      return 0;
+    }
+
+    // StaticMethod finds a static method by name in a load context, see FindAssembly. The assembly and type names
+    // are UTF-8 strings.
+    static MethodInfo StaticMethod(long context, IntPtr assembly, IntPtr type, IntPtr method) {
+      var t = FindAssembly(context, Marshal.PtrToStringUTF8(assembly)).GetType(Marshal.PtrToStringUTF8(type), true);
+      var methodName = Marshal.PtrToStringUTF8(method);
+      var methods = t.GetMethods(StaticMethods).Where(m => m.Name == methodName).ToArray();
+      if (methods.Length != 1) {
+        throw new MissingMethodException(t.FullName, methodName);
+      }
+      return methods[0];
+    }
+
+    static IntPtr FunctionPointer(MethodInfo method) {
+      // [UnmanagedCallersOnly] methods are already callable from native code:
+      if (IsUnmanagedCallersOnly(method)) {
+        return method.MethodHandle.GetFunctionPointer();
+      }
+      lock (delegates) {
+        if (!delegates.TryGetValue(method, out var d)) {
+          var parameters = method.GetParameters();
+          var type = DelegateType(DelegateModule(method), method.ReturnType, method.ReturnParameter, parameters.Select(p => p.ParameterType).ToArray(), parameters);
+          d = Delegate.CreateDelegate(type, method);
+          delegates[method] = d;
+        }
+        return Marshal.GetFunctionPointerForDelegate(d);
+      }
+    }
+
+    static bool IsUnmanagedCallersOnly(MethodInfo method) {
+      return method.GetCustomAttributesData().Any(a => a.AttributeType.FullName == "System.Runtime.InteropServices.UnmanagedCallersOnlyAttribute");
+    }
+
+    // DelegateType emits a non generic delegate type with the given signature in module, generic delegates
+    // like Func<> can't be marshaled to function pointers. The [MarshalAs] settings are copied from
+    // returnParameter and parameters, their entries can be null.
+    static Type DelegateType(ModuleBuilder module, Type returnType, ParameterInfo returnParameter, Type[] parameterTypes, ParameterInfo[] parameters) {
+      var typeName = "GoDotnet.Delegates.Delegate" + delegateTypeCount++;
+      var type = module.DefineType(typeName, TypeAttributes.Public | TypeAttributes.Sealed | TypeAttributes.AutoClass, typeof(MulticastDelegate));
+      var ctor = type.DefineConstructor(MethodAttributes.RTSpecialName | MethodAttributes.SpecialName | MethodAttributes.HideBySig | MethodAttributes.Public,
+        CallingConventions.Standard, new[] { typeof(object), typeof(IntPtr) });
+      ctor.SetImplementationFlags(MethodImplAttributes.Runtime | MethodImplAttributes.Managed);
+
+      var invoke = type.DefineMethod("Invoke", MethodAttributes.Public | MethodAttributes.HideBySig | MethodAttributes.NewSlot | MethodAttributes.Virtual,
+        returnType, parameterTypes);
+      invoke.SetImplementationFlags(MethodImplAttributes.Runtime | MethodImplAttributes.Managed);
+      if (returnParameter != null) {
+        CopyMarshaling(invoke.DefineParameter(0, ParameterAttributes.Retval, null), returnParameter);
+      }
+      for (var i = 0; i < parameterTypes.Length; i++) {
+        var parameter = i < parameters.Length ? parameters[i] : null;
+        if (parameter == null) {
+          continue;
+        }
+        var attributes = parameter.Attributes & (ParameterAttributes.In | ParameterAttributes.Out);
+        CopyMarshaling(invoke.DefineParameter(i + 1, attributes, parameter.Name), parameter);
+      }
+      return type.CreateTypeInfo();
+    }
+
+    // CopyMarshaling keeps the [MarshalAs] settings of the original method.
+    static void CopyMarshaling(ParameterBuilder builder, ParameterInfo parameter) {
+      foreach (var data in parameter.GetCustomAttributesData()) {
+        if (data.AttributeType != typeof(MarshalAsAttribute)) {
+          continue;
+        }
+        var args = data.ConstructorArguments.Select(Value).ToArray();
+        var fields = data.NamedArguments.Where(n => n.IsField).ToArray();
+        builder.SetCustomAttribute(new CustomAttributeBuilder(data.Constructor, args,
+          fields.Select(n => (FieldInfo)n.MemberInfo).ToArray(),
+          fields.Select(n => Value(n.TypedValue)).ToArray()));
+      }
+    }
+
+    static object Value(CustomAttributeTypedArgument argument) {
+      if (argument.ArgumentType.IsEnum) {
+        return Enum.ToObject(argument.ArgumentType, argument.Value);
+      }
+      return argument.Value;
+    }
+  }
+}
@@ -0,0 +1,241 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using System.Runtime.InteropServices;
+using System.Runtime.Loader;
+using System.Text.Json;
+
+namespace GoDotnet {
+  public static partial class Host {
+    // Invoke operations, see objectNew in object.go.
+    const int OpNew = 0;
+    const int OpCall = 1;
+    const int OpGet = 2;
+    const int OpSet = 3;
+    const int OpFree = 4;
+    const int OpCallAsync = 5;
+    const int OpCancel = 6;
+
+    const BindingFlags PublicMembers = BindingFlags.Public | BindingFlags.Instance | BindingFlags.Static;
+
+    [StructLayout(LayoutKind.Sequential)]
+    struct InvokeArgs {
+      public int Op;
+      public IntPtr Handle;
+      public IntPtr Assembly;
+      public IntPtr Type;
+      public IntPtr Member;
+      public IntPtr Args;
+      public IntPtr Result;
+      public ulong Task;
+      public IntPtr Completion;
+    }
+
+    // Invoke works with objects held by GCHandles: it creates them (OpNew, Assembly and Type), calls their public
+    // methods (OpCall), reads and writes their public properties and fields (OpGet and OpSet) and frees their handles
+    // (OpFree). OpCallAsync and OpCancel start and cancel the calls of methods returning a Task, see CallAsync; the
+    // static methods are called with Assembly and Type instead of a handle. Args is a JSON array of values and Result
+    // receives a JSON object allocated with AllocCoTaskMem, {"value": value} or {"exception": exception}, see
+    // StoreException. A value is {"type": "int32", "value": 1}, {"type": "null"} or {"type": "object", "handle": 1,
+    // "class": "Ns.Type"} for the other objects, which are returned in new handles.
+    public static int Invoke(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<InvokeArgs>(args);
+        Marshal.WriteIntPtr(args, (int)Marshal.OffsetOf<InvokeArgs>("Result"), InvokeResult(() => InvokeOperation(a)));
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // InvokeResult returns the Result of Invoke for the value returned by f.
+    static IntPtr InvokeResult(Func<object> f) {
+      string result;
+      try {
+        result = JsonSerializer.Serialize(new { value = EncodeValue(f()) });
+      } catch (TargetInvocationException e) when (e.InnerException != null) {
+        result = JsonSerializer.Serialize(new { exception = Describe(e.InnerException) });
+      } catch (Exception e) {
+        result = JsonSerializer.Serialize(new { exception = Describe(e) });
+      }
+      return Marshal.StringToCoTaskMemUTF8(result);
+    }
+
+    static object InvokeOperation(InvokeArgs a) {
+      switch (a.Op) {
+      case OpFree:
+        GCHandle.FromIntPtr(a.Handle).Free();
+        return null;
+      case OpCancel:
+        Cancel(a.Task);
+        return null;
+      }
+      var member = Marshal.PtrToStringUTF8(a.Member);
+      var values = DecodeValues(Marshal.PtrToStringUTF8(a.Args));
+      // Static methods are called without a handle.
+      var target = a.Handle == IntPtr.Zero ? null : GCHandle.FromIntPtr(a.Handle).Target;
+      var t = target?.GetType() ?? LoadType(a);
+      switch (a.Op) {
+      case OpNew:
+        if (t.IsValueType && values.Length == 0) {
+          return Activator.CreateInstance(t);
+        }
+        var ctor = Overload(t.GetConstructors(), values, out var ctorArgs);
+        if (ctor == null) {
+          throw new MissingMethodException(t.FullName, ".ctor");
+        }
+        return ctor.Invoke(ctorArgs);
+      case OpCallAsync:
+        return CallAsync(a.Task, a.Completion, t, target, member, values);
+      case OpCall:
+        var method = Overload(t.GetMethods(PublicMembers).Where(m => m.Name == member), values, out var methodArgs);
+        if (method == null) {
+          throw new MissingMethodException(t.FullName, member);
+        }
+        return method.Invoke(method.IsStatic ? null : target, methodArgs);
+      case OpGet:
+      case OpSet:
+        var property = t.GetProperty(member, PublicMembers);
+        var field = property == null ? t.GetField(member, PublicMembers) : null;
+        if (property == null && field == null) {
+          throw new MissingMemberException(t.FullName, member);
+        }
+        if (a.Op == OpGet) {
+          return property != null ? property.GetValue(target) : field.GetValue(target);
+        }
+        if (values.Length != 1 || !TryConvert(values[0], property?.PropertyType ?? field.FieldType, out var value)) {
+          throw new ArgumentException("Invalid value for " + t.FullName + "." + member);
+        }
+        if (property != null) {
+          property.SetValue(target, value);
+        } else {
+          field.SetValue(target, value);
+        }
+        return null;
+      }
+      throw new ArgumentOutOfRangeException(nameof(a.Op));
+    }
+
+    static Type LoadType(InvokeArgs a) {
+      return FindAssembly(0, Marshal.PtrToStringUTF8(a.Assembly)).GetType(Marshal.PtrToStringUTF8(a.Type), true);
+    }
+
+    // Overload returns the first method or constructor that accepts the values, converted in args.
+    static T Overload<T>(IEnumerable<T> candidates, object[] values, out object[] args) where T : MethodBase {
+      foreach (var candidate in candidates) {
+        var parameters = candidate.GetParameters();
+        if (parameters.Length != values.Length) {
+          continue;
+        }
+        args = new object[values.Length];
+        var ok = true;
+        for (var i = 0; i < values.Length && ok; i++) {
+          ok = TryConvert(values[i], parameters[i].ParameterType, out args[i]);
+        }
+        if (ok) {
+          return candidate;
+        }
+      }
+      args = null;
+      return null;
+    }
+
+    // TryConvert converts a decoded value to a parameter type, numbers are converted when they fit.
+    static bool TryConvert(object value, Type type, out object result) {
+      result = value;
+      if (value == null) {
+        return !type.IsValueType || Nullable.GetUnderlyingType(type) != null;
+      }
+      if (type.IsInstanceOfType(value)) {
+        return true;
+      }
+      var target = Nullable.GetUnderlyingType(type) ?? type;
+      if (value is IConvertible && !(value is string) && (target.IsPrimitive || target.IsEnum)) {
+        try {
+          var underlying = target.IsEnum ? Enum.GetUnderlyingType(target) : target;
+          result = Convert.ChangeType(value, underlying);
+          if (target.IsEnum) {
+            result = Enum.ToObject(target, result);
+          }
+          return true;
+        } catch (Exception e) when (e is OverflowException || e is InvalidCastException) {
+          return false;
+        }
+      }
+      return false;
+    }
+
+    static object[] DecodeValues(string json) {
+      if (string.IsNullOrEmpty(json)) {
+        return new object[0];
+      }
+      using var document = JsonDocument.Parse(json);
+      return document.RootElement.EnumerateArray().Select(DecodeValue).ToArray();
+    }
+
+    static object DecodeValue(JsonElement e) {
+      var type = e.GetProperty("type").GetString();
+      if (type == "null") {
+        return null;
+      }
+      if (type == "object") {
+        return GCHandle.FromIntPtr(new IntPtr(e.GetProperty("handle").GetInt64())).Target;
+      }
+      var v = e.GetProperty("value");
+      switch (type) {
+      case "bool": return v.GetBoolean();
+      case "int8": return v.GetSByte();
+      case "int16": return v.GetInt16();
+      case "int32": return v.GetInt32();
+      case "int64": return v.GetInt64();
+      case "uint8": return v.GetByte();
+      case "uint16": return v.GetUInt16();
+      case "uint32": return v.GetUInt32();
+      case "uint64": return v.GetUInt64();
+      case "float32": return v.GetSingle();
+      case "float64": return v.GetDouble();
+      case "string": return v.GetString();
+      case "bytes": return v.GetBytesFromBase64();
+      }
+      throw new ArgumentException("Unknown value type " + type);
+    }
+
+    static readonly Dictionary<Type, string> valueTypes = new Dictionary<Type, string> {
+      { typeof(bool), "bool" },
+      { typeof(sbyte), "int8" },
+      { typeof(short), "int16" },
+      { typeof(int), "int32" },
+      { typeof(long), "int64" },
+      { typeof(byte), "uint8" },
+      { typeof(ushort), "uint16" },
+      { typeof(char), "uint16" },
+      { typeof(uint), "uint32" },
+      { typeof(ulong), "uint64" },
+      { typeof(float), "float32" },
+      { typeof(double), "float64" },
+      { typeof(string), "string" },
+      { typeof(byte[]), "bytes" },
+    };
+
+    // EncodeValue returns the JSON form of a value, objects are stored in new handles.
+    static object EncodeValue(object value) {
+      if (value == null) {
+        return new { type = "null" };
+      }
+      var t = value.GetType();
+      if (t.IsEnum) {
+        t = Enum.GetUnderlyingType(t);
+        value = Convert.ChangeType(value, t);
+      }
+      if (value is char c) {
+        value = (ushort)c;
+      }
+      if (valueTypes.TryGetValue(t, out var name)) {
+        return new { type = name, value };
+      }
+      var handle = GCHandle.ToIntPtr(GCHandle.Alloc(value)).ToInt64();
+      return new { type = "object", handle, @class = t.FullName };
+    }
+  }
+}
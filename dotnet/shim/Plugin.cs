@@ -0,0 +1,131 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Reflection;
+using System.Reflection.Emit;
+using System.Runtime.InteropServices;
+using System.Runtime.Loader;
+
+namespace GoDotnet {
+  // PluginLoadContext is a collectible load context for a plugin assembly and the assemblies next to it. They're
+  // read in memory, the files can be replaced while the plugin is loaded. The other assemblies come from the
+  // default context.
+  class PluginLoadContext : AssemblyLoadContext {
+    readonly string directory;
+
+    public readonly Assembly Assembly;
+    // DelegateTypes holds the delegate types of the plugin methods, they're collected with the plugin.
+    public readonly ModuleBuilder DelegateTypes;
+
+    public PluginLoadContext(string path) : base(Path.GetFileName(path), isCollectible: true) {
+      directory = Path.GetDirectoryName(Path.GetFullPath(path));
+      try {
+        Assembly = LoadFile(path);
+      } catch {
+        Unload();
+        throw;
+      }
+      using (EnterContextualReflection()) {
+        var name = new AssemblyName("GoDotnet.PluginDelegates");
+        DelegateTypes = AssemblyBuilder.DefineDynamicAssembly(name, AssemblyBuilderAccess.RunAndCollect).DefineDynamicModule(name.Name);
+      }
+    }
+
+    protected override Assembly Load(AssemblyName name) {
+      var path = Path.Combine(directory, name.Name + ".dll");
+      return File.Exists(path) ? LoadFile(path) : null;
+    }
+
+    Assembly LoadFile(string path) {
+      var symbols = Path.ChangeExtension(path, ".pdb");
+      using var image = new MemoryStream(File.ReadAllBytes(path));
+      using var pdb = File.Exists(symbols) ? new MemoryStream(File.ReadAllBytes(symbols)) : null;
+      return LoadFromStream(image, pdb);
+    }
+  }
+
+  public static partial class Host {
+    [StructLayout(LayoutKind.Sequential)]
+    struct LoadPluginArgs {
+      public IntPtr Path;
+      public long Context;
+      public IntPtr Name;
+    }
+
+    // The loaded plugins by context ID, the default context is 0.
+    static readonly Dictionary<long, PluginLoadContext> plugins = new Dictionary<long, PluginLoadContext>();
+    static long lastPlugin;
+
+    // LoadPlugin loads an assembly in a new PluginLoadContext. Context receives the context ID, for BindMethod and
+    // UnloadPlugin, and Name the assembly name, a UTF-8 string allocated with AllocCoTaskMem.
+    public static int LoadPlugin(IntPtr args, int sizeBytes) {
+      try {
+        var a = Marshal.PtrToStructure<LoadPluginArgs>(args);
+        var context = new PluginLoadContext(Marshal.PtrToStringUTF8(a.Path));
+        long id;
+        lock (plugins) {
+          id = ++lastPlugin;
+          plugins[id] = context;
+        }
+        Marshal.WriteInt64(args, (int)Marshal.OffsetOf<LoadPluginArgs>("Context"), id);
+        Marshal.WriteIntPtr(args, (int)Marshal.OffsetOf<LoadPluginArgs>("Name"), Marshal.StringToCoTaskMemUTF8(context.Assembly.GetName().Name));
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // UnloadPlugin forgets the delegates of a plugin and unloads its context, args points to the context ID.
+    // The context is collected once managed code doesn't use it anymore.
+    public static int UnloadPlugin(IntPtr args, int sizeBytes) {
+      try {
+        var id = Marshal.ReadInt64(args);
+        PluginLoadContext context;
+        lock (plugins) {
+          if (!plugins.Remove(id, out context)) {
+            return 0;
+          }
+        }
+        lock (delegates) {
+          foreach (var method in delegates.Keys.Concat(wrappers.Keys).Where(m => AssemblyLoadContext.GetLoadContext(m.Module.Assembly) == context).ToList()) {
+            delegates.Remove(method);
+            wrappers.Remove(method);
+          }
+        }
+        context.Unload();
+        return 0;
+      } catch (Exception e) {
+        return e.HResult;
+      }
+    }
+
+    // FindAssembly loads an assembly by name in a plugin context, or in the default one.
+    static Assembly FindAssembly(long context, string name) {
+      var assemblyName = new AssemblyName(name);
+      if (context == 0) {
+        return AssemblyLoadContext.Default.LoadFromAssemblyName(assemblyName);
+      }
+      PluginLoadContext plugin;
+      lock (plugins) {
+        if (!plugins.TryGetValue(context, out plugin)) {
+          throw new InvalidOperationException("The plugin is unloaded");
+        }
+      }
+      return plugin.Assemblies.FirstOrDefault(a => AssemblyName.ReferenceMatchesDefinition(assemblyName, a.GetName())) ??
+        plugin.LoadFromAssemblyName(assemblyName);
+    }
+
+    // DelegateModule returns the module for the delegate types of a method, plugin methods get their plugin one.
+    static ModuleBuilder DelegateModule(MethodInfo method) {
+      if (AssemblyLoadContext.GetLoadContext(method.Module.Assembly) is PluginLoadContext plugin) {
+        return plugin.DelegateTypes;
+      }
+      if (delegateTypes == null) {
+        var name = new AssemblyName("GoDotnet.Delegates");
+        delegateTypes = AssemblyBuilder.DefineDynamicAssembly(name, AssemblyBuilderAccess.Run).DefineDynamicModule(name.Name);
+      }
+      return delegateTypes;
+    }
+  }
+}
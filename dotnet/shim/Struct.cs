@@ -0,0 +1,83 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using System.Runtime.InteropServices;
+
+namespace GoDotnet {
+  public static partial class Host {
+    static bool IsStruct(Type t) {
+      return t.IsValueType && !t.IsPrimitive && !t.IsEnum && t != typeof(void);
+    }
+
+    // ParameterLayout describes a struct parameter, passed by value or as a ref or pointer, null for other types.
+    static object ParameterLayout(Type t) {
+      var byValue = true;
+      if (t.IsByRef || t.IsPointer) {
+        t = t.GetElementType();
+        byValue = false;
+      }
+      if (!IsStruct(t)) {
+        return null;
+      }
+      var fields = new List<object>();
+      var align = 1;
+      string error = null;
+      try {
+        if (t.IsAutoLayout) {
+          throw new ArgumentException(t.FullName + " doesn't have a sequential or explicit layout");
+        }
+        Flatten(t, 0, "", fields, ref align);
+        var pack = t.StructLayoutAttribute?.Pack ?? 0;
+        if (pack > 0 && pack < align) {
+          align = pack;
+        }
+      } catch (ArgumentException e) {
+        error = e.Message;
+      }
+      return new {
+        byValue,
+        type = t.FullName,
+        size = error == null ? Marshal.SizeOf(t) : 0,
+        align,
+        fields,
+        error,
+      };
+    }
+
+    // Flatten lists the primitive fields of a blittable struct, nested structs included, by offset.
+    static void Flatten(Type t, int offset, string prefix, List<object> fields, ref int align) {
+      var members = t.GetFields(BindingFlags.Instance | BindingFlags.Public | BindingFlags.NonPublic)
+        .Select(f => (field: f, offset: offset + (int)Marshal.OffsetOf(t, f.Name)))
+        .OrderBy(f => f.offset);
+      foreach (var (field, fieldOffset) in members) {
+        var name = prefix + field.Name;
+        var fieldType = field.FieldType;
+        if (fieldTypes.TryGetValue(fieldType, out var typeName)) {
+          fields.Add(new { name, offset = fieldOffset, type = typeName });
+          align = Math.Max(align, Marshal.SizeOf(fieldType));
+        } else if (IsStruct(fieldType) && !fieldType.IsAutoLayout) {
+          Flatten(fieldType, fieldOffset, name + ".", fields, ref align);
+        } else {
+          throw new ArgumentException(t.FullName + "." + field.Name + " isn't blittable, its type is " + fieldType);
+        }
+      }
+    }
+
+    // fieldTypes maps the blittable primitive types to the type names used by the Go package.
+    static readonly Dictionary<Type, string> fieldTypes = new Dictionary<Type, string> {
+      { typeof(sbyte), "int8" },
+      { typeof(short), "int16" },
+      { typeof(int), "int32" },
+      { typeof(long), "int64" },
+      { typeof(byte), "uint8" },
+      { typeof(ushort), "uint16" },
+      { typeof(uint), "uint32" },
+      { typeof(ulong), "uint64" },
+      { typeof(IntPtr), "uintptr" },
+      { typeof(UIntPtr), "uintptr" },
+      { typeof(float), "float32" },
+      { typeof(double), "float64" },
+    };
+  }
+}
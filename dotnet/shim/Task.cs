@@ -0,0 +1,89 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Runtime.InteropServices;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+
+namespace GoDotnet {
+  public static partial class Host {
+    // TaskCompletion is the Go callback of CallAsync, it receives the Result of Invoke for the task and returns 0
+    // when Go doesn't read it: the task was abandoned by Shutdown, or the callback is closed.
+    delegate int TaskCompletion(ulong task, IntPtr result);
+
+    // The cancellation sources of the running tasks, by task ID.
+    static readonly Dictionary<ulong, CancellationTokenSource> cancellations = new Dictionary<ulong, CancellationTokenSource>();
+
+    // CallAsync calls a method returning a Task or a Task<T>. A method whose last parameter is a CancellationToken
+    // receives one that OpCancel signals. Once the task completes, completion is called with the task ID and its
+    // result, on the thread that completed the task: the callback returns right away and doesn't block it.
+    static object CallAsync(ulong task, IntPtr completion, Type t, object target, string member, object[] values) {
+      var candidates = t.GetMethods(PublicMembers).Where(m => m.Name == member && typeof(Task).IsAssignableFrom(m.ReturnType));
+      var cancellation = new CancellationTokenSource();
+      var withToken = candidates.Where(m => m.GetParameters().LastOrDefault()?.ParameterType == typeof(CancellationToken));
+      var method = Overload(withToken, values.Append(cancellation.Token).ToArray(), out var args) ?? Overload(candidates, values, out args);
+      if (method == null) {
+        throw new MissingMethodException(t.FullName, member);
+      }
+
+      lock (cancellations) {
+        cancellations[task] = cancellation;
+      }
+      Task result;
+      try {
+        result = (Task)method.Invoke(method.IsStatic ? null : target, args);
+        if (result == null) {
+          throw new InvalidOperationException(t.FullName + "." + member + " returned a null Task");
+        }
+      } catch {
+        RemoveCancellation(task);
+        throw;
+      }
+      var complete = Marshal.GetDelegateForFunctionPointer<TaskCompletion>(completion);
+      result.ContinueWith(done => {
+        RemoveCancellation(task);
+        var value = InvokeResult(() => TaskResult(done, method.ReturnType));
+        if (complete(task, value) == 0) {
+          ReleaseResult(value);
+        }
+      }, CancellationToken.None, TaskContinuationOptions.ExecuteSynchronously, TaskScheduler.Default);
+      return null;
+    }
+
+    // TaskResult returns the result of a completed task, or throws its exception like await does.
+    static object TaskResult(Task task, Type type) {
+      task.GetAwaiter().GetResult();
+      if (type.IsGenericType && type.GetGenericTypeDefinition() == typeof(Task<>)) {
+        return type.GetProperty(nameof(Task<object>.Result)).GetValue(task);
+      }
+      return null;
+    }
+
+    // ReleaseResult frees a Result of Invoke that Go didn't read, along with the handle of its object.
+    static void ReleaseResult(IntPtr result) {
+      using (var json = JsonDocument.Parse(Marshal.PtrToStringUTF8(result))) {
+        if (json.RootElement.TryGetProperty("value", out var value) && value.GetProperty("type").GetString() == "object") {
+          GCHandle.FromIntPtr(new IntPtr(value.GetProperty("handle").GetInt64())).Free();
+        }
+      }
+      Marshal.FreeCoTaskMem(result);
+    }
+
+    static void Cancel(ulong task) {
+      CancellationTokenSource cancellation;
+      lock (cancellations) {
+        cancellations.TryGetValue(task, out cancellation);
+      }
+      cancellation?.Cancel();
+    }
+
+    // RemoveCancellation forgets the source of a task. It isn't disposed, Cancel may be running on another thread,
+    // and a source without timeout doesn't hold resources.
+    static void RemoveCancellation(ulong task) {
+      lock (cancellations) {
+        cancellations.Remove(task);
+      }
+    }
+  }
+}
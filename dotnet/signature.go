@@ -0,0 +1,370 @@
+package dotnet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unsafe"
+)
+
+// ErrInvalidSignature is returned when a delegate signature can't be parsed or isn't supported.
+var ErrInvalidSignature = errors.New("Invalid delegate signature")
+
+// ErrInvalidArgument is returned when a delegate argument doesn't match its signature.
+var ErrInvalidArgument = errors.New("Invalid delegate argument")
+
+// kind is the type of a delegate parameter or result.
+type kind int
+
+const (
+	kindVoid kind = iota
+	kindInt8
+	kindInt16
+	kindInt32
+	kindInt64
+	kindUint8
+	kindUint16
+	kindUint32
+	kindUint64
+	kindUintptr
+	kindPointer
+	kindFloat32
+	kindFloat64
+	kindString
+	kindUTF16String
+	kindStruct
+	kindStructPointer
+	kindSlice
+)
+
+var kindNames = map[kind]string{
+	kindVoid:    "void",
+	kindInt8:    "int8",
+	kindInt16:   "int16",
+	kindInt32:   "int32",
+	kindInt64:   "int64",
+	kindUint8:   "uint8",
+	kindUint16:  "uint16",
+	kindUint32:  "uint32",
+	kindUint64:  "uint64",
+	kindUintptr: "uintptr",
+	kindPointer: "pointer",
+	kindFloat32: "float32",
+	kindFloat64: "float64",
+
+	kindString:      "string",
+	kindUTF16String: "utf16string",
+
+	// Structs and slices are only supported by Bind, parseSignature doesn't accept them.
+	kindStruct:        "struct",
+	kindStructPointer: "*struct",
+	kindSlice:         "slice",
+}
+
+func (k kind) String() string {
+	return kindNames[k]
+}
+
+// Trampoline classes, see trampolines_gen.go.
+const (
+	classVoid = iota
+	classInt
+	classFloat
+	classDouble
+)
+
+// class returns the trampoline class of the kind.
+func (k kind) class() int {
+	switch k {
+	case kindVoid:
+		return classVoid
+	case kindFloat32:
+		return classFloat
+	case kindFloat64:
+		return classDouble
+	}
+	return classInt
+}
+
+// bits returns the size of an integer kind.
+func (k kind) bits() uint {
+	switch k {
+	case kindInt8, kindUint8:
+		return 8
+	case kindInt16, kindUint16:
+		return 16
+	case kindInt32, kindUint32:
+		return 32
+	}
+	return 64
+}
+
+func (k kind) signed() bool {
+	return k >= kindInt8 && k <= kindInt64
+}
+
+func (k kind) isStruct() bool {
+	return k == kindStruct || k == kindStructPointer
+}
+
+// matches reports whether a managed parameter or result, named by GoDotnet.Host.TypeName, is passed like the kind.
+// IntPtr and unmanaged pointers are interchangeable, the struct layouts are compared by checkStructs and the
+// slices by checkTypes.
+func (k kind) matches(managed string) bool {
+	switch k {
+	case kindUintptr, kindPointer:
+		return managed == "uintptr" || managed == "pointer" || strings.HasPrefix(managed, "*")
+	case kindStructPointer:
+		return managed == "pointer"
+	}
+	return managed == k.String()
+}
+
+// bindOnly reports whether the kind is only supported by Bind.
+func (k kind) bindOnly() bool {
+	return k.isStruct() || k == kindSlice
+}
+
+// signature describes the parameters and the result of a native function.
+type signature struct {
+	result kind
+	params []kind
+	// returnsError is set for the functions passed to Bind with an extra error result.
+	returnsError bool
+}
+
+// parseSignature parses a signature like "int32(int32,int32)" or "void()".
+// The supported types are int8, int16, int32, int64, uint8, uint16, uint32, uint64,
+// uintptr, pointer, float32, float64, string (UTF-8) and utf16string, void is only valid as the result.
+func parseSignature(s string) (signature, error) {
+	var sig signature
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(strings.TrimSpace(s), ")") {
+		return sig, fmt.Errorf("%w: %q, expected result(params)", ErrInvalidSignature, s)
+	}
+	var err error
+	if sig.result, err = parseKind(s, s[:open]); err != nil {
+		return sig, err
+	}
+	params := strings.TrimSpace(s[open+1 : strings.LastIndexByte(s, ')')])
+	if params == "" {
+		return sig, nil
+	}
+	for _, name := range strings.Split(params, ",") {
+		k, err := parseKind(s, name)
+		if err != nil {
+			return sig, err
+		}
+		if k == kindVoid {
+			return sig, fmt.Errorf("%w: %q, void parameter", ErrInvalidSignature, s)
+		}
+		sig.params = append(sig.params, k)
+	}
+	if len(sig.params) > MaxDelegateArity {
+		return sig, fmt.Errorf("%w: %q, more than %d parameters", ErrInvalidSignature, s, MaxDelegateArity)
+	}
+	return sig, nil
+}
+
+func parseKind(s, name string) (kind, error) {
+	name = strings.TrimSpace(name)
+	for k, n := range kindNames {
+		if n == name && !k.bindOnly() {
+			return k, nil
+		}
+	}
+	return kindVoid, fmt.Errorf("%w: %q, unknown type %q", ErrInvalidSignature, s, name)
+}
+
+// String returns the signature in the format accepted by parseSignature.
+func (s signature) String() string {
+	params := make([]string, len(s.params))
+	for i, k := range s.params {
+		params[i] = k.String()
+	}
+	return s.result.String() + "(" + strings.Join(params, ",") + ")"
+}
+
+func (s signature) hasStructs() bool {
+	return s.has(kind.isStruct)
+}
+
+// bindOnly reports whether the signature uses kinds only supported by Bind.
+func (s signature) bindOnly() bool {
+	return s.has(kind.bindOnly)
+}
+
+func (s signature) has(f func(kind) bool) bool {
+	if f(s.result) {
+		return true
+	}
+	for _, k := range s.params {
+		if f(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// native returns the signature of the native function: structs are passed as pointers, a struct
+// result is stored through an extra trailing pointer, see BindMethod in the helper,
+// and slices are passed as a pointer and an int32 length.
+func (s signature) native() signature {
+	n := signature{result: s.result}
+	for _, k := range s.params {
+		switch k {
+		case kindStruct, kindStructPointer:
+			n.params = append(n.params, kindPointer)
+		case kindSlice:
+			n.params = append(n.params, kindPointer, kindInt32)
+		default:
+			n.params = append(n.params, k)
+		}
+	}
+	if s.result == kindStruct {
+		n.result = kindVoid
+		n.params = append(n.params, kindPointer)
+	}
+	return n
+}
+
+// trampoline returns the index of the generated trampoline matching the signature:
+// trampolines are grouped by arity, each parameter class is a base 3 digit and there's
+// one trampoline per result class.
+func (s signature) trampoline() int {
+	index, offset, digit := 0, 0, 1
+	for range s.params {
+		offset += digit
+		digit *= 3
+	}
+	digit = 1
+	for _, k := range s.params {
+		index += (k.class() - classInt) * digit
+		digit *= 3
+	}
+	return (offset+index)*4 + s.result.class()
+}
+
+// argument converts a Go value to the raw value passed to the trampoline.
+// Integer kinds accept any Go integer that fits, floats accept float32 and float64,
+// pointer and uintptr accept unsafe.Pointer, uintptr and nil. Strings are handled by the callers,
+// they need an allocation.
+func (k kind) argument(v interface{}) (uint64, error) {
+	switch k {
+	case kindFloat32, kindFloat64:
+		var f float64
+		switch v := v.(type) {
+		case float32:
+			f = float64(v)
+		case float64:
+			f = v
+		default:
+			return 0, fmt.Errorf("%w: %T for %s", ErrInvalidArgument, v, k)
+		}
+		if k == kindFloat32 {
+			return uint64(math.Float32bits(float32(f))), nil
+		}
+		return math.Float64bits(f), nil
+	case kindPointer, kindUintptr:
+		switch v := v.(type) {
+		case nil:
+			return 0, nil
+		case unsafe.Pointer:
+			return uint64(uintptr(v)), nil
+		case uintptr:
+			return uint64(v), nil
+		}
+		return 0, fmt.Errorf("%w: %T for %s", ErrInvalidArgument, v, k)
+	}
+
+	var i int64
+	var u uint64
+	var unsigned bool
+	switch v := v.(type) {
+	case int:
+		i = int64(v)
+	case int8:
+		i = int64(v)
+	case int16:
+		i = int64(v)
+	case int32:
+		i = int64(v)
+	case int64:
+		i = v
+	case uint:
+		u, unsigned = uint64(v), true
+	case uint8:
+		u, unsigned = uint64(v), true
+	case uint16:
+		u, unsigned = uint64(v), true
+	case uint32:
+		u, unsigned = uint64(v), true
+	case uint64:
+		u, unsigned = v, true
+	case uintptr:
+		u, unsigned = uint64(v), true
+	default:
+		return 0, fmt.Errorf("%w: %T for %s", ErrInvalidArgument, v, k)
+	}
+
+	bits := k.bits()
+	if k.signed() {
+		if unsigned {
+			if u > math.MaxInt64>>(64-bits) {
+				return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidArgument, u, k)
+			}
+			i = int64(u)
+		}
+		if i < math.MinInt64>>(64-bits) || i > math.MaxInt64>>(64-bits) {
+			return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidArgument, i, k)
+		}
+		return uint64(i), nil
+	}
+	if !unsigned {
+		if i < 0 {
+			return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidArgument, i, k)
+		}
+		u = uint64(i)
+	}
+	if u > math.MaxUint64>>(64-bits) {
+		return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidArgument, u, k)
+	}
+	return u, nil
+}
+
+// result converts the raw value returned by the trampoline to a Go value of the kind.
+// Strings are copied, the native memory isn't released.
+func (k kind) result(r uint64) interface{} {
+	switch k {
+	case kindVoid:
+		return nil
+	case kindInt8:
+		return int8(r)
+	case kindInt16:
+		return int16(r)
+	case kindInt32:
+		return int32(r)
+	case kindInt64:
+		return int64(r)
+	case kindUint8:
+		return uint8(r)
+	case kindUint16:
+		return uint16(r)
+	case kindUint32:
+		return uint32(r)
+	case kindUint64:
+		return r
+	case kindUintptr:
+		return uintptr(r)
+	case kindPointer:
+		return rawPointer(r)
+	case kindString, kindUTF16String:
+		return k.goString(rawPointer(r))
+	case kindFloat32:
+		return math.Float32frombits(uint32(r))
+	case kindFloat64:
+		return math.Float64frombits(r)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package dotnet
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrLayoutMismatch matches every LayoutError.
+var ErrLayoutMismatch = errors.New("Struct layout mismatch")
+
+// LayoutError is returned by Bind when a Go struct doesn't have the layout of the managed struct.
+type LayoutError struct {
+	// GoType and ManagedType are the compared types.
+	GoType, ManagedType string
+	// Reason describes the first difference.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *LayoutError) Error() string {
+	return fmt.Sprintf("%s doesn't match %s: %s", e.GoType, e.ManagedType, e.Reason)
+}
+
+// Is reports whether target is ErrLayoutMismatch.
+func (e *LayoutError) Is(target error) bool {
+	return target == ErrLayoutMismatch
+}
+
+// structLayout describes the memory layout of a struct, the helper returns it as JSON for managed structs.
+type structLayout struct {
+	// ByValue is false for managed structs passed by reference or as pointers.
+	ByValue bool          `json:"byValue"`
+	Type    string        `json:"type"`
+	Size    uintptr       `json:"size"`
+	Align   uintptr       `json:"align"`
+	Fields  []structField `json:"fields"`
+	// Error is set when the managed struct isn't blittable.
+	Error string `json:"error"`
+}
+
+// structField is a field of a flattened struct: nested structs are replaced by their fields.
+type structField struct {
+	Name   string  `json:"name"`
+	Offset uintptr `json:"offset"`
+	Type   string  `json:"type"`
+}
+
+// goLayout returns the layout of a Go struct, it fails unless every field is a fixed size number or a struct of them.
+func goLayout(t reflect.Type) (*structLayout, error) {
+	l := &structLayout{Type: t.String(), Size: t.Size(), Align: uintptr(t.Align())}
+	return l, l.flatten(t, 0, "")
+}
+
+func (l *structLayout) flatten(t reflect.Type, offset uintptr, prefix string) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := prefix + f.Name
+		ft := f.Type
+		if f.Name == "_" && ft.Kind() == reflect.Array {
+			// Padding, like _ [3]uint8.
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct {
+			if err := l.flatten(ft, offset+f.Offset, name+"."); err != nil {
+				return err
+			}
+			continue
+		}
+		k, ok := reflectKinds[ft.Kind()]
+		if !ok || k == kindPointer || k.isString() {
+			return fmt.Errorf("%w: %s.%s isn't blittable, its type is %s", ErrInvalidSignature, l.Type, name, f.Type)
+		}
+		if f.Name != "_" {
+			l.Fields = append(l.Fields, structField{Name: name, Offset: offset + f.Offset, Type: k.String()})
+		}
+	}
+	return nil
+}
+
+// compare returns a LayoutError if the managed layout m differs from l.
+func (l *structLayout) compare(m *structLayout) error {
+	mismatch := func(format string, args ...interface{}) error {
+		return &LayoutError{GoType: l.Type, ManagedType: m.Type, Reason: fmt.Sprintf(format, args...)}
+	}
+	switch {
+	case m.Error != "":
+		return mismatch("%s", m.Error)
+	case l.Size != m.Size:
+		return mismatch("size is %d, expected %d", l.Size, m.Size)
+	case l.Align != m.Align:
+		return mismatch("alignment is %d, expected %d", l.Align, m.Align)
+	case len(l.Fields) != len(m.Fields):
+		return mismatch("%d fields, expected %d", len(l.Fields), len(m.Fields))
+	}
+	for i, f := range l.Fields {
+		if mf := m.Fields[i]; f.Offset != mf.Offset || f.Type != mf.Type {
+			return mismatch("field %s is %s at offset %d, expected %s %s at offset %d", f.Name, f.Type, f.Offset, mf.Name, mf.Type, mf.Offset)
+		}
+	}
+	return nil
+}
+
+// managedParams returns the kinds of the managed parameters and of the result matching a function type t
+// with the signature sig, slices take two parameters. layouts holds the layouts of the Go structs, by kind.
+// t is nil for the signatures of NewDelegate, they don't have structs and slices.
+func managedParams(t reflect.Type, sig signature) (kinds []kind, layouts []*structLayout, err error) {
+	var types []reflect.Type
+	for i, k := range sig.params {
+		kinds = append(kinds, k)
+		if k.isStruct() {
+			types = append(types, t.In(i))
+		} else {
+			types = append(types, nil)
+		}
+		if k == kindSlice {
+			kinds = append(kinds, kindInt32)
+			types = append(types, nil)
+		}
+	}
+	kinds = append(kinds, sig.result)
+	if sig.result.isStruct() {
+		types = append(types, t.Out(0))
+	} else {
+		types = append(types, nil)
+	}
+	layouts = make([]*structLayout, len(kinds))
+	for i, st := range types {
+		if st == nil {
+			continue
+		}
+		if kinds[i] == kindStructPointer {
+			st = st.Elem()
+		}
+		if layouts[i], err = goLayout(st); err != nil {
+			return nil, nil, err
+		}
+	}
+	return kinds, layouts, nil
+}
+
+// checkStructs checks that the structs of the Go function match the managed structs, and that the
+// managed method doesn't take or return structs where the Go function doesn't.
+func (d *Delegate) checkStructs(name string, kinds []kind, layouts, managed []*structLayout) error {
+	for i, m := range managed {
+		k := kinds[i]
+		switch {
+		case k == kindStruct && (m == nil || !m.ByValue):
+			return fmt.Errorf("%w: %s, %s of %s.%s isn't a struct passed by value", ErrInvalidSignature, name, position(i, len(kinds)-1), d.typ, d.method)
+		case k == kindStructPointer && (m == nil || m.ByValue):
+			return fmt.Errorf("%w: %s, %s of %s.%s isn't a struct passed by reference", ErrInvalidSignature, name, position(i, len(kinds)-1), d.typ, d.method)
+		case k != kindStruct && m != nil && m.ByValue:
+			return fmt.Errorf("%w: %s, %s of %s.%s is a struct passed by value", ErrInvalidSignature, name, position(i, len(kinds)-1), d.typ, d.method)
+		}
+		if k.isStruct() {
+			if err := layouts[i].compare(m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// position names a parameter, or the result when i is the number of parameters.
+func position(i, params int) string {
+	if i == params {
+		return "the result"
+	}
+	return fmt.Sprintf("parameter %d", i)
+}
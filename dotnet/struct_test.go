@@ -0,0 +1,162 @@
+package dotnet
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testPoint struct {
+	X, Y int32
+}
+
+// testRecord matches Test.Record.
+type testRecord struct {
+	ID        int64
+	Version   int32
+	Kind      int16
+	Flags     uint8
+	Priority  int8
+	Price     float64
+	Weight    float32
+	Count     uint32
+	Timestamp uint64
+	Region    uint16
+	Delta     int16
+	Quantity  int32
+	Total     float64
+	Discount  float32
+	Owner     int32
+	Parent    int64
+	Status    uint8
+	Position  testPoint
+	Score     float64
+	Checksum  int64
+}
+
+func TestStructLayout(t *testing.T) {
+	l, err := goLayout(reflect.TypeOf(struct {
+		A uint8
+		_ [3]uint8
+		B testPoint
+		C float64
+	}{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &structLayout{Size: 24, Align: 8, Fields: []structField{
+		{Name: "A", Offset: 0, Type: "uint8"},
+		{Name: "B.X", Offset: 4, Type: "int32"},
+		{Name: "B.Y", Offset: 8, Type: "int32"},
+		{Name: "C", Offset: 16, Type: "float64"},
+	}}
+	l.Type = ""
+	if !reflect.DeepEqual(l, expected) {
+		t.Fatalf("Got %+v", l)
+	}
+
+	for _, v := range []interface{}{struct{ S string }{}, struct{ P *int32 }{}, struct{ B bool }{}, struct{ I int }{}} {
+		if _, err := goLayout(reflect.TypeOf(v)); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("%T: got %v", v, err)
+		}
+	}
+
+	point, _ := goLayout(reflect.TypeOf(testPoint{}))
+	mismatches := []*structLayout{
+		{Size: 12, Align: 4, Fields: point.Fields},
+		{Size: 8, Align: 8, Fields: point.Fields},
+		{Size: 8, Align: 4, Fields: point.Fields[:1]},
+		{Size: 8, Align: 4, Fields: []structField{{"X", 0, "int32"}, {"Y", 4, "float32"}}},
+		{Error: "Test.Named.Name isn't blittable"},
+	}
+	for _, m := range mismatches {
+		if err := point.compare(m); !errors.Is(err, ErrLayoutMismatch) {
+			t.Fatalf("%+v: got %v", m, err)
+		}
+	}
+	if err := point.compare(&structLayout{Size: 8, Align: 4, Fields: point.Fields}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStructDelegates(t *testing.T) {
+	translate, err := Bind[func(testPoint, int32, int32) testPoint](testRuntime, "Test", "Test.TestClass", "Translate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := translate(testPoint{1, 2}, 10, 20); p != (testPoint{11, 22}) {
+		t.Fatalf("Got %+v", p)
+	}
+
+	grow, err := Bind[func(*testPoint, int32)](testRuntime, "Test", "Test.TestClass", "Grow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := testPoint{3, -4}
+	grow(&p, 3)
+	if p != (testPoint{9, -12}) {
+		t.Fatalf("Got %+v", p)
+	}
+	area, err := Bind[func(*testPoint) int32](testRuntime, "Test", "Test.TestClass", "Area")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := area(&p); a != -108 {
+		t.Fatalf("Got %d", a)
+	}
+
+	next, err := Bind[func(testRecord) testRecord](testRuntime, "Test", "Test.TestClass", "NextRecord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := testRecord{ID: 1, Version: 2, Kind: 3, Flags: 4, Priority: -5, Price: 1.25, Count: 6, Timestamp: 7, Region: 8,
+		Delta: -9, Quantity: 10, Owner: 11, Parent: 12, Status: 13, Position: testPoint{14, 15}, Score: 0.5}
+	n := next(r)
+	expected := r
+	expected.ID, expected.Version, expected.Price, expected.Position = 2, 3, 2.5, testPoint{15, 14}
+	expected.Checksum = 2 + 3 + 3 + 4 - 5 + 6 + 7 + 8 - 9 + 10 + 11 + 12 + 13 + 15 + 14
+	if n != expected {
+		t.Fatalf("Got %+v", n)
+	}
+
+	if _, err := Bind[func(struct{ A, B int32 }) int32](testRuntime, "Test", "Test.TestClass", "PackedSum"); !errors.Is(err, ErrLayoutMismatch) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(struct {
+		A uint8
+		B [4]uint8
+	}) int32](testRuntime, "Test", "Test.TestClass", "PackedSum"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	var layoutErr *LayoutError
+	if _, err := Bind[func(struct{ P uintptr }) int32](testRuntime, "Test", "Test.TestClass", "NameLength"); !errors.As(err, &layoutErr) || layoutErr.ManagedType != "Test.Named" {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(*testPoint, int32, int32) testPoint](testRuntime, "Test", "Test.TestClass", "Translate"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(testPoint, int32)](testRuntime, "Test", "Test.TestClass", "Grow"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(testPoint, int32, int32) int64](testRuntime, "Test", "Test.TestClass", "Translate"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := Bind[func(testRecord, int32, int32, int32) testRecord](testRuntime, "Test", "Test.TestClass", "NextRecord"); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.NewCallback(func(p testPoint) {}); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+func BenchmarkBindRecord(b *testing.B) {
+	next, err := Bind[func(testRecord) testRecord](testRuntime, "Test", "Test.TestClass", "NextRecord")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var r testRecord
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r = next(r)
+	}
+}
@@ -0,0 +1,167 @@
+package dotnet
+
+import (
+	"context"
+	"errors"
+	"unsafe"
+)
+
+// ErrTaskPending is returned by Task.Result before the task completes.
+var ErrTaskPending = errors.New("Task is pending")
+
+// Task is a call to a managed method returning a Task or a Task<T>, started by CallAsync.
+// It completes with the managed task, Done and Wait let goroutines wait for it.
+type Task struct {
+	runtime *Runtime
+	id      uint64
+	ctx     context.Context
+
+	// result and err are set before done is closed.
+	done   chan struct{}
+	result interface{}
+	err    error
+}
+
+// CallAsync calls a public static method returning a Task or a Task<T>, it returns once the method returns its task.
+// The arguments are converted like the Object ones. When the method takes a CancellationToken as its last parameter,
+// it receives one that's canceled when ctx is done. The task completes with the result of a Task<T> (nil for a Task),
+// the *ManagedException of a faulted task, or ctx.Err() when it's canceled after ctx is done.
+//
+// The managed task completes on its own thread, usually a thread pool one: it hands the result to the waiting
+// goroutines and returns, managed threads never wait for Go.
+func (r *Runtime) CallAsync(ctx context.Context, assembly, typ, method string, args ...interface{}) (*Task, error) {
+	call := invocation{op: objectCallAsync, assembly: assembly, typ: typ, member: method, args: args}
+	return r.callAsync(ctx, call, r.invoke)
+}
+
+// CallAsync calls a public method of the object returning a Task or a Task<T>, like Runtime.CallAsync.
+func (o *Object) CallAsync(ctx context.Context, method string, args ...interface{}) (*Task, error) {
+	return o.runtime.callAsync(ctx, invocation{op: objectCallAsync, member: method, args: args}, o.invoke)
+}
+
+// Done returns a channel that's closed when the task completes.
+func (t *Task) Done() <-chan struct{} {
+	return t.done
+}
+
+// Result returns the result of a completed task, or ErrTaskPending until Done is closed.
+func (t *Task) Result() (interface{}, error) {
+	select {
+	case <-t.done:
+		return t.result, t.err
+	default:
+		return nil, ErrTaskPending
+	}
+}
+
+// Wait waits for the task to complete and returns its result. It returns ctx.Err() when ctx is done first,
+// the managed task keeps running: the context passed to CallAsync cancels it.
+func (t *Task) Wait(ctx context.Context) (interface{}, error) {
+	if result, err := t.Result(); err != ErrTaskPending {
+		return result, err
+	}
+	select {
+	case <-t.done:
+		return t.result, t.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *Runtime) callAsync(ctx context.Context, call invocation, invoke func(invocation) (interface{}, error)) (*Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	completion, err := r.taskCompletion()
+	if err != nil {
+		return nil, err
+	}
+
+	// The task is registered first, the managed one may complete before the call returns.
+	t := &Task{runtime: r, ctx: ctx, done: make(chan struct{})}
+	r.tasksMu.Lock()
+	if r.tasks == nil {
+		r.tasks = make(map[uint64]*Task)
+	}
+	r.lastTask++
+	t.id = r.lastTask
+	r.tasks[t.id] = t
+	r.tasksMu.Unlock()
+
+	call.task, call.completion = t.id, completion
+	if _, err := invoke(call); err != nil {
+		r.removeTask(t.id)
+		return nil, err
+	}
+	if ctx.Done() != nil {
+		go t.cancelOnDone()
+	}
+	return t, nil
+}
+
+// cancelOnDone signals the CancellationToken of the task when its context is done first.
+func (t *Task) cancelOnDone() {
+	select {
+	case <-t.ctx.Done():
+		if _, err := t.runtime.invoke(invocation{op: objectCancel, task: t.id}); err != nil {
+			t.runtime.log(LevelDebug, "Can't cancel the task", Fields{"error": err.Error()})
+		}
+	case <-t.done:
+	}
+}
+
+// taskCompletion returns the pointer of the callback that completes the tasks, it's registered once per runtime.
+func (r *Runtime) taskCompletion() (unsafe.Pointer, error) {
+	r.tasksMu.Lock()
+	defer r.tasksMu.Unlock()
+	if r.taskCallback == nil {
+		c, err := r.NewCallback(r.completeTask)
+		if err != nil {
+			return nil, err
+		}
+		r.taskCallback = c
+	}
+	return r.taskCallback.Pointer(), nil
+}
+
+// completeTask is called by the continuation of the managed task with the result of GoDotnet.Host.Invoke,
+// it must not block. It returns 0 when the task was abandoned by Shutdown, the managed side releases the
+// result then, like when the callback was closed by Shutdown and managed code receives the zero value.
+func (r *Runtime) completeTask(id uint64, result unsafe.Pointer) int32 {
+	t := r.removeTask(id)
+	if t == nil {
+		return 0
+	}
+	value, err := r.invokeResult(result)
+	var e *ManagedException
+	if errors.As(err, &e) && e.canceled() && t.ctx.Err() != nil {
+		err = t.ctx.Err()
+	}
+	t.complete(value, err)
+	return 1
+}
+
+func (r *Runtime) removeTask(id uint64) *Task {
+	r.tasksMu.Lock()
+	defer r.tasksMu.Unlock()
+	t := r.tasks[id]
+	delete(r.tasks, id)
+	return t
+}
+
+// abandonTasks completes the pending tasks with a StateError, the runtime stopped.
+func (r *Runtime) abandonTasks() {
+	r.tasksMu.Lock()
+	tasks := r.tasks
+	r.tasks = nil
+	r.tasksMu.Unlock()
+	for _, t := range tasks {
+		t.complete(nil, &StateError{Op: OpTask, State: r.State()})
+	}
+}
+
+// complete is called once, by the task owner after removing it from the pending tasks.
+func (t *Task) complete(result interface{}, err error) {
+	t.result, t.err = result, err
+	close(t.done)
+}
@@ -0,0 +1,118 @@
+package dotnet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTask(t *testing.T) {
+	ctx := context.Background()
+	task, err := testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "AddLater", 40, 2, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.Result(); !errors.Is(err, ErrTaskPending) {
+		t.Fatalf("Got %v", err)
+	}
+	<-task.Done()
+	if result, err := task.Result(); result != int32(42) || err != nil {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+
+	// Wait stops waiting, the task keeps running:
+	task, err = testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "AddLater", 1, 2, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := task.Wait(short); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Got %v", err)
+	}
+	if result, err := task.Wait(ctx); result != int32(3) || err != nil {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+
+	// The context cancels the CancellationToken:
+	canceled, cancel := context.WithCancel(ctx)
+	task, err = testRuntime.CallAsync(canceled, "Test", "Test.AsyncClass", "Forever")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if result, err := task.Wait(ctx); result != nil || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+	if _, err := testRuntime.CallAsync(canceled, "Test", "Test.AsyncClass", "AddLater", 1, 2, 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Got %v", err)
+	}
+	expiring, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	task, err = testRuntime.CallAsync(expiring, "Test", "Test.AsyncClass", "Forever")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Got %v", err)
+	}
+
+	task, err = testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "FailLater", "later")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e *ManagedException
+	if _, err := task.Wait(ctx); !errors.As(err, &e) || e.Type != "System.InvalidOperationException" || e.Message != "later" {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "Broken"); !errors.As(err, &e) || e.Type != "System.ArgumentException" {
+		t.Fatalf("Got %v", err)
+	}
+	if _, err := testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "NotAsync"); !errors.Is(err, ErrMissingMethodException) {
+		t.Fatalf("Got %v", err)
+	}
+
+	// A completed task returns an object:
+	task, err = testRuntime.CallAsync(ctx, "Test", "Test.AsyncClass", "CounterNow", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := task.Wait(ctx)
+	counter, ok := result.(*Object)
+	if !ok || err != nil {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+	defer counter.Close()
+	task, err = counter.CallAsync(ctx, "AddAsync", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, err := task.Wait(ctx); result != int32(7) || err != nil {
+		t.Fatalf("Got %v, %v", result, err)
+	}
+	counter.Close()
+	if _, err := counter.CallAsync(ctx, "AddAsync", 4); !errors.Is(err, ErrObjectClosed) {
+		t.Fatalf("Got %v", err)
+	}
+}
+
+func TestTaskConcurrency(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int32) {
+			defer wg.Done()
+			task, err := testRuntime.CallAsync(context.Background(), "Test", "Test.AsyncClass", "AddLater", i, i, i%10)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if result, err := task.Wait(context.Background()); result != 2*i || err != nil {
+				t.Errorf("Got %v, %v", result, err)
+			}
+		}(int32(i))
+	}
+	wg.Wait()
+}
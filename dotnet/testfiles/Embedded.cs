@@ -0,0 +1,12 @@
+using System;
+
+namespace Embedded {
+  public static class Entry {
+    public static int Quadruple(int n) {
+      return EmbeddedUtil.Numbers.Twice(EmbeddedUtil.Numbers.Twice(n));
+    }
+    public static int Fail(int n) {
+      throw new InvalidOperationException("embedded " + n);
+    }
+  }
+}
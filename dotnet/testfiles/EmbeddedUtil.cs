@@ -0,0 +1,7 @@
+namespace EmbeddedUtil {
+  public static class Numbers {
+    public static int Twice(int n) {
+      return 2 * n;
+    }
+  }
+}
@@ -0,0 +1,19 @@
+using System;
+using System.Threading;
+
+namespace Exec {
+  public class Program {
+    public static int Main(string[] args) {
+      Console.WriteLine("args: " + string.Join(",", args));
+      if (args.Length == 0) {
+        return 0;
+      }
+      // "wait" keeps the assembly running, for the concurrent calls tests:
+      if (Array.IndexOf(args, "wait") >= 0) {
+        Thread.Sleep(20);
+        Console.WriteLine("done");
+      }
+      return int.Parse(args[0]) + args.Length - 1;
+    }
+  }
+}
@@ -0,0 +1,29 @@
+using System.Runtime.InteropServices;
+
+namespace Plugin {
+  [StructLayout(LayoutKind.Sequential)]
+  public struct Pair {
+    public int A;
+    public int B;
+  }
+
+  public static class Rules {
+    static int calls;
+
+#if V2
+    public static int Version() {
+      return 2;
+    }
+#else
+    public static int Version() {
+      return 1;
+    }
+#endif
+    public static int Calls() {
+      return ++calls;
+    }
+    public static int Sum(ref Pair p) {
+      return EmbeddedUtil.Numbers.Twice(p.A + p.B) / 2;
+    }
+  }
+}
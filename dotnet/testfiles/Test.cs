@@ -1,12 +1,281 @@
 using System;
+using System.Linq;
+using System.Runtime.Loader;
+using System.Runtime.InteropServices;
+using System.Threading;
+using System.Threading.Tasks;
 
 namespace Test {
+  public delegate int BinaryOp(int a, int b);
+  public delegate double ScaleOp(double value, float factor);
+  public delegate float MixOp(double a, int b, float c, long d);
+  public delegate float FloatsOp(float a, float b, float c, float d);
+  public delegate long InterleavedOp(float a, long b, double c, int d);
+  public delegate string Greeter(string name);
+
+  [StructLayout(LayoutKind.Sequential)]
+  public struct Point {
+    public int X;
+    public int Y;
+  }
+
+  [StructLayout(LayoutKind.Sequential)]
+  public struct Record {
+    public long Id;
+    public int Version;
+    public short Kind;
+    public byte Flags;
+    public sbyte Priority;
+    public double Price;
+    public float Weight;
+    public uint Count;
+    public ulong Timestamp;
+    public ushort Region;
+    public short Delta;
+    public int Quantity;
+    public double Total;
+    public float Discount;
+    public int Owner;
+    public long Parent;
+    public byte Status;
+    public Point Position;
+    public double Score;
+    public long Checksum;
+  }
+
+  [StructLayout(LayoutKind.Sequential, Pack = 1)]
+  public struct Packed {
+    public byte A;
+    public int B;
+  }
+
+  public struct Named {
+    public string Name;
+  }
+
+  public enum Mode : short {
+    Off,
+    On,
+  }
+
+  public class Counter {
+    public static int Live;
+
+    public int Value { get; set; }
+    public string Name { get; set; }
+    public Mode Mode;
+
+    public Counter() : this(0) {
+    }
+    public Counter(int start) {
+      Value = start;
+      Live++;
+    }
+    public Counter(string name, long start) : this(checked((int)start)) {
+      Name = name;
+    }
+    public int Add(int n) {
+      return Value += n;
+    }
+    public long Add(long n, long m) {
+      return Value += checked((int)(n + m));
+    }
+    public Counter Clone() {
+      return new Counter(Name, Value);
+    }
+    public bool SameValue(Counter other) {
+      return other != null && other.Value == Value;
+    }
+    public byte[] Bytes(byte[] prefix) {
+      var result = new byte[prefix.Length + 1];
+      prefix.CopyTo(result, 0);
+      result[prefix.Length] = (byte)Value;
+      return result;
+    }
+    public void Fail() {
+      throw new InvalidOperationException("counter " + Name);
+    }
+    public async Task<int> AddAsync(int n, CancellationToken token) {
+      await Task.Delay(10, token);
+      return Add(n);
+    }
+    public int Apply(long op, int b) {
+      return TestClass.Apply((IntPtr)op, Value, b);
+    }
+    public static int LiveCounters() {
+      GC.Collect();
+      GC.WaitForPendingFinalizers();
+      return Live;
+    }
+    ~Counter() {
+      Live--;
+    }
+  }
+
+  public static class AsyncClass {
+    public static async Task<int> AddLater(int a, int b, int delay) {
+      await Task.Delay(delay);
+      return a + b;
+    }
+    public static async Task Forever(CancellationToken token) {
+      await Task.Delay(Timeout.Infinite, token);
+    }
+    public static async Task<string> FailLater(string message) {
+      await Task.Yield();
+      throw new InvalidOperationException(message);
+    }
+    public static Task<Counter> CounterNow(int start) {
+      return Task.FromResult(new Counter(start));
+    }
+    public static Task Broken() {
+      throw new ArgumentException("broken before the task");
+    }
+    public static int NotAsync() {
+      return 1;
+    }
+  }
+
   public class TestClass {
+    public static int LoadContexts(string name) {
+      for (var i = 0; i < 10; i++) {
+        GC.Collect();
+        GC.WaitForPendingFinalizers();
+      }
+      return AssemblyLoadContext.All.Count(c => c.Name == name);
+    }
     public static int Add(int a, int b) {
       return a+b;
     }
     public static string String() {
       return "teststring";
     }
+    public static string GetData(string name) {
+      return AppContext.GetData(name) as string;
+    }
+    public static void Print(string message) {
+      Console.WriteLine(message);
+      Console.Error.WriteLine("error: " + message);
+    }
+    public static void SetExitCode(int exitCode) {
+      Environment.ExitCode = exitCode;
+    }
+    public static double Scale(double value, float factor, int times) {
+      return value * factor * times;
+    }
+    public static float Half(float value) {
+      return value / 2;
+    }
+    public static long Mix(sbyte a, short b, long c, byte d) {
+      return a + b + c + d;
+    }
+    public static uint Negate(uint value) {
+      return ~value;
+    }
+    public static IntPtr Offset(IntPtr p, int n) {
+      return p + n;
+    }
+    public static int Apply(IntPtr op, int a, int b) {
+      return Marshal.GetDelegateForFunctionPointer<BinaryOp>(op)(a, b);
+    }
+    public static int ApplyOnThread(IntPtr op, int a, int b) {
+      var result = 0;
+      var thread = new Thread(() => result = Marshal.GetDelegateForFunctionPointer<BinaryOp>(op)(a, b));
+      thread.Start();
+      thread.Join();
+      return result;
+    }
+    public static double ApplyScale(IntPtr op, double value, float factor) {
+      return Marshal.GetDelegateForFunctionPointer<ScaleOp>(op)(value, factor);
+    }
+    public static float ApplyMix(IntPtr op) {
+      return Marshal.GetDelegateForFunctionPointer<MixOp>(op)(0.5, -2, 1.25f, 1L << 40);
+    }
+    public static float ApplyFloats(IntPtr op) {
+      return Marshal.GetDelegateForFunctionPointer<FloatsOp>(op)(1.5f, -2.25f, 3.125f, 1e30f);
+    }
+    public static long ApplyInterleaved(IntPtr op) {
+      return Marshal.GetDelegateForFunctionPointer<InterleavedOp>(op)(-0.75f, -(1L << 33), 2.5, 7);
+    }
+    public static string Greet(string name) {
+      return "Hello " + name;
+    }
+    [return: MarshalAs(UnmanagedType.LPWStr)]
+    public static string Shout([MarshalAs(UnmanagedType.LPWStr)] string s) {
+      return s.ToUpperInvariant() + "!";
+    }
+    public static string ApplyGreeter(IntPtr greeter, string name) {
+      return Marshal.GetDelegateForFunctionPointer<Greeter>(greeter)(name) + "?";
+    }
+    public static Point Translate(Point p, int dx, int dy) {
+      return new Point { X = p.X + dx, Y = p.Y + dy };
+    }
+    public static void Grow(ref Point p, int factor) {
+      p.X *= factor;
+      p.Y *= factor;
+    }
+    public static unsafe int Area(Point* p) {
+      return p->X * p->Y;
+    }
+    public static Record NextRecord(Record r) {
+      r.Id++;
+      r.Version++;
+      r.Price *= 2;
+      r.Position.X++;
+      r.Position.Y--;
+      r.Checksum = r.Id + r.Version + r.Kind + r.Flags + r.Priority + r.Count + (long)r.Timestamp + r.Region + r.Delta +
+        r.Quantity + r.Owner + r.Parent + r.Status + r.Position.X + r.Position.Y;
+      return r;
+    }
+    public static int PackedSum(Packed p) {
+      return p.A + p.B;
+    }
+    public static int NameLength(Named n) {
+      return n.Name.Length;
+    }
+    public static unsafe long SumInt32(int* data, int length) {
+      long sum = 0;
+      foreach (var v in new Span<int>(data, length)) {
+        sum += v;
+      }
+      return sum;
+    }
+    public static unsafe void Fill(byte* buffer, int length, byte value) {
+      new Span<byte>(buffer, length).Fill(value);
+    }
+    public static unsafe double Dot(double* a, int aLength, double* b, int bLength) {
+      var x = new ReadOnlySpan<double>(a, aLength);
+      var y = new ReadOnlySpan<double>(b, bLength);
+      var dot = 0.0;
+      for (var i = 0; i < x.Length && i < y.Length; i++) {
+        dot += x[i] * y[i];
+      }
+      return dot;
+    }
+    public static unsafe Point ShiftBy(Point p, int* deltas, int length) {
+      foreach (var d in new Span<int>(deltas, length)) {
+        p.X += d;
+        p.Y -= d;
+      }
+      return p;
+    }
+    public static int Divide(int a, int b) {
+      return a / b;
+    }
+    public static int Length(IntPtr s) {
+      return Marshal.PtrToStringUTF8(s).Length;
+    }
+    public static string Fail(string message) {
+      try {
+        throw new ArgumentException("inner " + message, "message");
+      } catch (ArgumentException e) {
+        throw new InvalidOperationException(message, e);
+      }
+    }
+    public static Point FailAll(Point p) {
+      throw new AggregateException(new FormatException("first"), new TimeoutException("second"));
+    }
+    public static int Component(IntPtr args, int sizeBytes) {
+      return sizeBytes * 2;
+    }
   }
 }
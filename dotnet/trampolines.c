@@ -0,0 +1,12916 @@
+// Code generated by trampolines_gen.go; DO NOT EDIT.
+
+#include <stdlib.h>
+#include <string.h>
+#include "trampolines.h"
+#include "_cgo_export.h"
+
+typedef void (*trampoline)(void* f, const uint64_t* a, uint64_t* r);
+
+static float argFloat(uint64_t a) {
+  uint32_t bits = (uint32_t)a;
+  float v;
+  memcpy(&v, &bits, sizeof(v));
+  return v;
+}
+
+static double argDouble(uint64_t a) {
+  double v;
+  memcpy(&v, &a, sizeof(v));
+  return v;
+}
+
+static uint64_t resultFloat(float v) {
+  uint32_t bits;
+  memcpy(&bits, &v, sizeof(v));
+  return bits;
+}
+
+static uint64_t resultDouble(double v) {
+  uint64_t bits;
+  memcpy(&bits, &v, sizeof(v));
+  return bits;
+}
+
+static void trampoline_v_(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(void))f)();
+}
+
+static void trampoline_i_(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(void))f)();
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(void))f)();
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(void))f)();
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_i(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t))f)((int64_t)a[0]);
+}
+
+static void trampoline_i_i(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t))f)((int64_t)a[0]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_i(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t))f)((int64_t)a[0]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_i(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t))f)((int64_t)a[0]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_f(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float))f)(argFloat(a[0]));
+}
+
+static void trampoline_i_f(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float))f)(argFloat(a[0]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_f(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float))f)(argFloat(a[0]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_f(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float))f)(argFloat(a[0]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_d(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double))f)(argDouble(a[0]));
+}
+
+static void trampoline_i_d(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double))f)(argDouble(a[0]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_d(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double))f)(argDouble(a[0]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_d(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double))f)(argDouble(a[0]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1]);
+}
+
+static void trampoline_i_ii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t))f)(argFloat(a[0]), (int64_t)a[1]);
+}
+
+static void trampoline_i_fi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t))f)(argFloat(a[0]), (int64_t)a[1]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t))f)(argFloat(a[0]), (int64_t)a[1]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t))f)(argFloat(a[0]), (int64_t)a[1]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_di(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t))f)(argDouble(a[0]), (int64_t)a[1]);
+}
+
+static void trampoline_i_di(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t))f)(argDouble(a[0]), (int64_t)a[1]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_di(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t))f)(argDouble(a[0]), (int64_t)a[1]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_di(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t))f)(argDouble(a[0]), (int64_t)a[1]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_if(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float))f)((int64_t)a[0], argFloat(a[1]));
+}
+
+static void trampoline_i_if(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float))f)((int64_t)a[0], argFloat(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_if(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float))f)((int64_t)a[0], argFloat(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_if(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float))f)((int64_t)a[0], argFloat(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float))f)(argFloat(a[0]), argFloat(a[1]));
+}
+
+static void trampoline_i_ff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float))f)(argFloat(a[0]), argFloat(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float))f)(argFloat(a[0]), argFloat(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float))f)(argFloat(a[0]), argFloat(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_df(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float))f)(argDouble(a[0]), argFloat(a[1]));
+}
+
+static void trampoline_i_df(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float))f)(argDouble(a[0]), argFloat(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_df(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float))f)(argDouble(a[0]), argFloat(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_df(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float))f)(argDouble(a[0]), argFloat(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_id(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double))f)((int64_t)a[0], argDouble(a[1]));
+}
+
+static void trampoline_i_id(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double))f)((int64_t)a[0], argDouble(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_id(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double))f)((int64_t)a[0], argDouble(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_id(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double))f)((int64_t)a[0], argDouble(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double))f)(argFloat(a[0]), argDouble(a[1]));
+}
+
+static void trampoline_i_fd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double))f)(argFloat(a[0]), argDouble(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double))f)(argFloat(a[0]), argDouble(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double))f)(argFloat(a[0]), argDouble(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double))f)(argDouble(a[0]), argDouble(a[1]));
+}
+
+static void trampoline_i_dd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double))f)(argDouble(a[0]), argDouble(a[1]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double))f)(argDouble(a[0]), argDouble(a[1]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double))f)(argDouble(a[0]), argDouble(a[1]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2]);
+}
+
+static void trampoline_i_iii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2]);
+}
+
+static void trampoline_i_fii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2]);
+}
+
+static void trampoline_i_dii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_ifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_ffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_dfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_idi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_fdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2]);
+}
+
+static void trampoline_i_ddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]));
+}
+
+static void trampoline_i_iif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]));
+}
+
+static void trampoline_i_fif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]));
+}
+
+static void trampoline_i_dif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_iff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_fff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_dff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_idf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_fdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]));
+}
+
+static void trampoline_i_ddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]));
+}
+
+static void trampoline_i_iid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]));
+}
+
+static void trampoline_i_fid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_did(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]));
+}
+
+static void trampoline_i_did(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_did(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_did(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_ifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_ffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_dfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_idd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_fdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]));
+}
+
+static void trampoline_i_ddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_iiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_fiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_diii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_ifii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_ffii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_dfii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_idii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_fdii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+}
+
+static void trampoline_i_ddii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_iifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_fifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_difi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_iffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_fffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_dffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_idfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_fdfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_ddfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_iidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_fidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_didi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_ifdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_ffdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_dfdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_iddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_fddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+}
+
+static void trampoline_i_dddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_iiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_fiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_diif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_ifif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_ffif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_dfif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_idif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_fdif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+}
+
+static void trampoline_i_ddif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_iiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_fiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_diff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_ifff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_ffff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_dfff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_idff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_fdff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_ddff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_iidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_fidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_didf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_ifdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_ffdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_dfdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_iddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_fddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+}
+
+static void trampoline_i_dddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_iiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_fiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_diid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_ifid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_ffid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_dfid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_idid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_fdid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+}
+
+static void trampoline_i_ddid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_iifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_fifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_difd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_iffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_fffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_dffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_idfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_fdfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_ddfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_iidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_fidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_didd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_ifdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_ffdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_dfdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_iddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_fddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+}
+
+static void trampoline_i_dddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_iiiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fiiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_diiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ifiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ffiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_dfiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_idiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fdiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddiii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ddiii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddiii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddiii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_iifii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fifii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_difii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_iffii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fffii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_dffii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_idfii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fdfii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ddfii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_iidii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, int64_t, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fidii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, int64_t, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_didii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, int64_t, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ifdii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, int64_t, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_ffdii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, int64_t, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_dfdii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, int64_t, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_iddii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, int64_t, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_fddii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, int64_t, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddii(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+}
+
+static void trampoline_i_dddii(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddii(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddii(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, int64_t, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iiifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fiifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_diifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ififi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ififi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ififi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ififi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ffifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dfifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_idifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fdifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddifi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ddifi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddifi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddifi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iiffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fiffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_diffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ifffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ffffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dfffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_idffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fdffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddffi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ddffi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddffi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddffi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iidfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, float, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fidfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, float, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_didfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, float, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ifdfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, float, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ffdfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, float, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dfdfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, float, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iddfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, float, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fddfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, float, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddfi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dddfi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddfi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddfi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, float, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iiidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fiidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_diidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ifidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ffidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dfidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ididi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ididi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ididi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ididi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fdidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddidi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ddidi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddidi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddidi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iifdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fifdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_difdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iffdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fffdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dffdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_idfdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fdfdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfdi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ddfdi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfdi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfdi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_iiddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, double, int64_t))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fiddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, double, int64_t))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_diddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, double, int64_t))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ifddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, double, int64_t))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ffddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, double, int64_t))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_dfddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, double, int64_t))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_idddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, double, int64_t))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_fdddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, double, int64_t))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddddi(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+}
+
+static void trampoline_i_ddddi(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddddi(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddddi(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, double, int64_t))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), (int64_t)a[4]);
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_iiiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fiiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_diiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ifiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ffiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_dfiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_idiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fdiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddiif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ddiif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddiif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddiif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_iifif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fifif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_difif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_iffif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fffif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_dffif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_idfif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fdfif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ddfif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_iidif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, int64_t, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fidif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, int64_t, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_didif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, int64_t, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ifdif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, int64_t, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_ffdif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, int64_t, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_dfdif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, int64_t, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_iddif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, int64_t, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_fddif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, int64_t, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddif(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+}
+
+static void trampoline_i_dddif(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddif(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddif(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, int64_t, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iiiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, float, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fiiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, float, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_diiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, float, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ififf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ififf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ififf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ififf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, float, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, float, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ffiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, float, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, float, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, float, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, float, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dfiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, float, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, float, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, float, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, float, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_idiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, float, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, float, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, float, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, float, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fdiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, float, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, float, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, float, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddiff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, float, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ddiff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, float, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddiff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, float, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddiff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, float, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iifff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, float, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fifff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, float, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_difff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, float, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iffff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, float, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fffff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, float, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dffff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, float, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_idfff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, float, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fdfff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, float, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ddfff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, float, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, float, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iidff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, float, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, float, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, float, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, float, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fidff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, float, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, float, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, float, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, float, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_didff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, float, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, float, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, float, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, float, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ifdff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, float, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, float, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, float, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, float, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ffdff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, float, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, float, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, float, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dfdff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, float, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iddff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, float, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fddff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, float, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddff(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dddff(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddff(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddff(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, float, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iiidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, double, float))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fiidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, double, float))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_diidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, double, float))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, double, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ifidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, double, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, double, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, double, float))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, double, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ffidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, double, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, double, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, double, float))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, double, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dfidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, double, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, double, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, double, float))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ididf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ididf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ididf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ididf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, double, float))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, double, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fdidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, double, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, double, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, double, float))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddidf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, double, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ddidf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, double, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddidf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, double, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddidf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, double, float))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, double, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iifdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, double, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, double, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, double, float))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, double, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fifdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, double, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, double, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, double, float))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, double, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_difdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, double, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, double, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, double, float))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iffdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, double, float))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fffdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, double, float))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dffdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, double, float))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_idfdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, double, float))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fdfdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, double, float))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfdf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ddfdf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfdf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfdf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, double, float))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_iiddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, double, float))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fiddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, double, float))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_diddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, double, float))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ifddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, double, float))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ffddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, double, float))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_dfddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, double, float))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_idddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, double, float))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_fdddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, double, float))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddddf(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+}
+
+static void trampoline_i_ddddf(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddddf(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddddf(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, double, float))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argFloat(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_iiiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fiiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_diiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ifiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ffiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_dfiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_idiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fdiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddiid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ddiid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddiid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddiid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_iifid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fifid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_difid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_iffid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fffid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_dffid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_idfid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fdfid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ddfid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_iidid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, int64_t, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fidid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, int64_t, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_didid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, int64_t, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ifdid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, int64_t, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_ffdid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, int64_t, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_dfdid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, int64_t, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_iddid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, int64_t, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_fddid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, int64_t, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddid(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+}
+
+static void trampoline_i_dddid(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddid(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddid(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, int64_t, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), (int64_t)a[3], argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iiifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, float, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fiifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, float, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_diifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, float, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ififd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ififd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ififd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ififd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, float, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, float, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ffifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, float, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, float, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, float, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, float, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dfifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, float, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, float, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, float, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, float, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_idifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, float, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, float, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, float, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, float, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fdifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, float, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, float, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, float, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddifd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, float, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ddifd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, float, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddifd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, float, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddifd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, float, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iiffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, float, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fiffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, float, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_diffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, float, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ifffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, float, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ffffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, float, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dfffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, float, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_idffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, float, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fdffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, float, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddffd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ddffd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddffd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddffd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, float, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iidfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, float, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iidfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, float, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iidfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, float, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iidfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, float, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fidfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, float, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fidfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, float, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fidfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, float, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fidfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, float, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_didfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, float, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_didfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, float, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_didfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, float, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_didfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, float, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifdfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ifdfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifdfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifdfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, float, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffdfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ffdfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffdfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffdfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, float, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfdfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dfdfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfdfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfdfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, float, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iddfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iddfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iddfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iddfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, float, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fddfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fddfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fddfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fddfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, float, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dddfd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dddfd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dddfd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dddfd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, float, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argFloat(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iiidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, int64_t, double, double))f)((int64_t)a[0], (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fiidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, int64_t, double, double))f)(argFloat(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_diidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, int64_t, double, double))f)(argDouble(a[0]), (int64_t)a[1], (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, int64_t, double, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ifidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, int64_t, double, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, int64_t, double, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, int64_t, double, double))f)((int64_t)a[0], argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, int64_t, double, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ffidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, int64_t, double, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, int64_t, double, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, int64_t, double, double))f)(argFloat(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, int64_t, double, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dfidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, int64_t, double, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, int64_t, double, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, int64_t, double, double))f)(argDouble(a[0]), argFloat(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ididd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ididd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ididd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ididd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, int64_t, double, double))f)((int64_t)a[0], argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, int64_t, double, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fdidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, int64_t, double, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, int64_t, double, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, int64_t, double, double))f)(argFloat(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddidd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, int64_t, double, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ddidd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, int64_t, double, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddidd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, int64_t, double, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddidd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, int64_t, double, double))f)(argDouble(a[0]), argDouble(a[1]), (int64_t)a[2], argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iifdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, float, double, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iifdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, float, double, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iifdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, float, double, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iifdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, float, double, double))f)((int64_t)a[0], (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fifdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, float, double, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fifdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, float, double, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fifdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, float, double, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fifdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, float, double, double))f)(argFloat(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_difdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, float, double, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_difdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, float, double, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_difdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, float, double, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_difdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, float, double, double))f)(argDouble(a[0]), (int64_t)a[1], argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iffdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iffdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iffdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iffdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, float, double, double))f)((int64_t)a[0], argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fffdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fffdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fffdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fffdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, float, double, double))f)(argFloat(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dffdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dffdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dffdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dffdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, float, double, double))f)(argDouble(a[0]), argFloat(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idfdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, float, double, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_idfdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, float, double, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idfdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, float, double, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idfdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, float, double, double))f)((int64_t)a[0], argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdfdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fdfdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdfdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdfdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, float, double, double))f)(argFloat(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddfdd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, float, double, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ddfdd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, float, double, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddfdd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, float, double, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddfdd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, float, double, double))f)(argDouble(a[0]), argDouble(a[1]), argFloat(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_iiddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, int64_t, double, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_iiddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, int64_t, double, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_iiddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, int64_t, double, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_iiddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, int64_t, double, double, double))f)((int64_t)a[0], (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fiddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, int64_t, double, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fiddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, int64_t, double, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fiddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, int64_t, double, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fiddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, int64_t, double, double, double))f)(argFloat(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_diddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, int64_t, double, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_diddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, int64_t, double, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_diddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, int64_t, double, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_diddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, int64_t, double, double, double))f)(argDouble(a[0]), (int64_t)a[1], argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ifddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, float, double, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ifddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, float, double, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ifddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, float, double, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ifddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, float, double, double, double))f)((int64_t)a[0], argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ffddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, float, double, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ffddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, float, double, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ffddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, float, double, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ffddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, float, double, double, double))f)(argFloat(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_dfddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, float, double, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_dfddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, float, double, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_dfddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, float, double, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_dfddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, float, double, double, double))f)(argDouble(a[0]), argFloat(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_idddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(int64_t, double, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_idddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(int64_t, double, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_idddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(int64_t, double, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_idddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(int64_t, double, double, double, double))f)((int64_t)a[0], argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_fdddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(float, double, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_fdddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(float, double, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_fdddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(float, double, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_fdddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(float, double, double, double, double))f)(argFloat(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static void trampoline_v_ddddd(void* f, const uint64_t* a, uint64_t* r) {
+  ((void (*)(double, double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+}
+
+static void trampoline_i_ddddd(void* f, const uint64_t* a, uint64_t* r) {
+  int64_t v = ((int64_t (*)(double, double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = (uint64_t)v;
+}
+
+static void trampoline_f_ddddd(void* f, const uint64_t* a, uint64_t* r) {
+  float v = ((float (*)(double, double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultFloat(v);
+}
+
+static void trampoline_d_ddddd(void* f, const uint64_t* a, uint64_t* r) {
+  double v = ((double (*)(double, double, double, double, double))f)(argDouble(a[0]), argDouble(a[1]), argDouble(a[2]), argDouble(a[3]), argDouble(a[4]));
+  *r = resultDouble(v);
+}
+
+static const trampoline trampolines[DELEGATE_TRAMPOLINES] = {
+  trampoline_v_,
+  trampoline_i_,
+  trampoline_f_,
+  trampoline_d_,
+  trampoline_v_i,
+  trampoline_i_i,
+  trampoline_f_i,
+  trampoline_d_i,
+  trampoline_v_f,
+  trampoline_i_f,
+  trampoline_f_f,
+  trampoline_d_f,
+  trampoline_v_d,
+  trampoline_i_d,
+  trampoline_f_d,
+  trampoline_d_d,
+  trampoline_v_ii,
+  trampoline_i_ii,
+  trampoline_f_ii,
+  trampoline_d_ii,
+  trampoline_v_fi,
+  trampoline_i_fi,
+  trampoline_f_fi,
+  trampoline_d_fi,
+  trampoline_v_di,
+  trampoline_i_di,
+  trampoline_f_di,
+  trampoline_d_di,
+  trampoline_v_if,
+  trampoline_i_if,
+  trampoline_f_if,
+  trampoline_d_if,
+  trampoline_v_ff,
+  trampoline_i_ff,
+  trampoline_f_ff,
+  trampoline_d_ff,
+  trampoline_v_df,
+  trampoline_i_df,
+  trampoline_f_df,
+  trampoline_d_df,
+  trampoline_v_id,
+  trampoline_i_id,
+  trampoline_f_id,
+  trampoline_d_id,
+  trampoline_v_fd,
+  trampoline_i_fd,
+  trampoline_f_fd,
+  trampoline_d_fd,
+  trampoline_v_dd,
+  trampoline_i_dd,
+  trampoline_f_dd,
+  trampoline_d_dd,
+  trampoline_v_iii,
+  trampoline_i_iii,
+  trampoline_f_iii,
+  trampoline_d_iii,
+  trampoline_v_fii,
+  trampoline_i_fii,
+  trampoline_f_fii,
+  trampoline_d_fii,
+  trampoline_v_dii,
+  trampoline_i_dii,
+  trampoline_f_dii,
+  trampoline_d_dii,
+  trampoline_v_ifi,
+  trampoline_i_ifi,
+  trampoline_f_ifi,
+  trampoline_d_ifi,
+  trampoline_v_ffi,
+  trampoline_i_ffi,
+  trampoline_f_ffi,
+  trampoline_d_ffi,
+  trampoline_v_dfi,
+  trampoline_i_dfi,
+  trampoline_f_dfi,
+  trampoline_d_dfi,
+  trampoline_v_idi,
+  trampoline_i_idi,
+  trampoline_f_idi,
+  trampoline_d_idi,
+  trampoline_v_fdi,
+  trampoline_i_fdi,
+  trampoline_f_fdi,
+  trampoline_d_fdi,
+  trampoline_v_ddi,
+  trampoline_i_ddi,
+  trampoline_f_ddi,
+  trampoline_d_ddi,
+  trampoline_v_iif,
+  trampoline_i_iif,
+  trampoline_f_iif,
+  trampoline_d_iif,
+  trampoline_v_fif,
+  trampoline_i_fif,
+  trampoline_f_fif,
+  trampoline_d_fif,
+  trampoline_v_dif,
+  trampoline_i_dif,
+  trampoline_f_dif,
+  trampoline_d_dif,
+  trampoline_v_iff,
+  trampoline_i_iff,
+  trampoline_f_iff,
+  trampoline_d_iff,
+  trampoline_v_fff,
+  trampoline_i_fff,
+  trampoline_f_fff,
+  trampoline_d_fff,
+  trampoline_v_dff,
+  trampoline_i_dff,
+  trampoline_f_dff,
+  trampoline_d_dff,
+  trampoline_v_idf,
+  trampoline_i_idf,
+  trampoline_f_idf,
+  trampoline_d_idf,
+  trampoline_v_fdf,
+  trampoline_i_fdf,
+  trampoline_f_fdf,
+  trampoline_d_fdf,
+  trampoline_v_ddf,
+  trampoline_i_ddf,
+  trampoline_f_ddf,
+  trampoline_d_ddf,
+  trampoline_v_iid,
+  trampoline_i_iid,
+  trampoline_f_iid,
+  trampoline_d_iid,
+  trampoline_v_fid,
+  trampoline_i_fid,
+  trampoline_f_fid,
+  trampoline_d_fid,
+  trampoline_v_did,
+  trampoline_i_did,
+  trampoline_f_did,
+  trampoline_d_did,
+  trampoline_v_ifd,
+  trampoline_i_ifd,
+  trampoline_f_ifd,
+  trampoline_d_ifd,
+  trampoline_v_ffd,
+  trampoline_i_ffd,
+  trampoline_f_ffd,
+  trampoline_d_ffd,
+  trampoline_v_dfd,
+  trampoline_i_dfd,
+  trampoline_f_dfd,
+  trampoline_d_dfd,
+  trampoline_v_idd,
+  trampoline_i_idd,
+  trampoline_f_idd,
+  trampoline_d_idd,
+  trampoline_v_fdd,
+  trampoline_i_fdd,
+  trampoline_f_fdd,
+  trampoline_d_fdd,
+  trampoline_v_ddd,
+  trampoline_i_ddd,
+  trampoline_f_ddd,
+  trampoline_d_ddd,
+  trampoline_v_iiii,
+  trampoline_i_iiii,
+  trampoline_f_iiii,
+  trampoline_d_iiii,
+  trampoline_v_fiii,
+  trampoline_i_fiii,
+  trampoline_f_fiii,
+  trampoline_d_fiii,
+  trampoline_v_diii,
+  trampoline_i_diii,
+  trampoline_f_diii,
+  trampoline_d_diii,
+  trampoline_v_ifii,
+  trampoline_i_ifii,
+  trampoline_f_ifii,
+  trampoline_d_ifii,
+  trampoline_v_ffii,
+  trampoline_i_ffii,
+  trampoline_f_ffii,
+  trampoline_d_ffii,
+  trampoline_v_dfii,
+  trampoline_i_dfii,
+  trampoline_f_dfii,
+  trampoline_d_dfii,
+  trampoline_v_idii,
+  trampoline_i_idii,
+  trampoline_f_idii,
+  trampoline_d_idii,
+  trampoline_v_fdii,
+  trampoline_i_fdii,
+  trampoline_f_fdii,
+  trampoline_d_fdii,
+  trampoline_v_ddii,
+  trampoline_i_ddii,
+  trampoline_f_ddii,
+  trampoline_d_ddii,
+  trampoline_v_iifi,
+  trampoline_i_iifi,
+  trampoline_f_iifi,
+  trampoline_d_iifi,
+  trampoline_v_fifi,
+  trampoline_i_fifi,
+  trampoline_f_fifi,
+  trampoline_d_fifi,
+  trampoline_v_difi,
+  trampoline_i_difi,
+  trampoline_f_difi,
+  trampoline_d_difi,
+  trampoline_v_iffi,
+  trampoline_i_iffi,
+  trampoline_f_iffi,
+  trampoline_d_iffi,
+  trampoline_v_fffi,
+  trampoline_i_fffi,
+  trampoline_f_fffi,
+  trampoline_d_fffi,
+  trampoline_v_dffi,
+  trampoline_i_dffi,
+  trampoline_f_dffi,
+  trampoline_d_dffi,
+  trampoline_v_idfi,
+  trampoline_i_idfi,
+  trampoline_f_idfi,
+  trampoline_d_idfi,
+  trampoline_v_fdfi,
+  trampoline_i_fdfi,
+  trampoline_f_fdfi,
+  trampoline_d_fdfi,
+  trampoline_v_ddfi,
+  trampoline_i_ddfi,
+  trampoline_f_ddfi,
+  trampoline_d_ddfi,
+  trampoline_v_iidi,
+  trampoline_i_iidi,
+  trampoline_f_iidi,
+  trampoline_d_iidi,
+  trampoline_v_fidi,
+  trampoline_i_fidi,
+  trampoline_f_fidi,
+  trampoline_d_fidi,
+  trampoline_v_didi,
+  trampoline_i_didi,
+  trampoline_f_didi,
+  trampoline_d_didi,
+  trampoline_v_ifdi,
+  trampoline_i_ifdi,
+  trampoline_f_ifdi,
+  trampoline_d_ifdi,
+  trampoline_v_ffdi,
+  trampoline_i_ffdi,
+  trampoline_f_ffdi,
+  trampoline_d_ffdi,
+  trampoline_v_dfdi,
+  trampoline_i_dfdi,
+  trampoline_f_dfdi,
+  trampoline_d_dfdi,
+  trampoline_v_iddi,
+  trampoline_i_iddi,
+  trampoline_f_iddi,
+  trampoline_d_iddi,
+  trampoline_v_fddi,
+  trampoline_i_fddi,
+  trampoline_f_fddi,
+  trampoline_d_fddi,
+  trampoline_v_dddi,
+  trampoline_i_dddi,
+  trampoline_f_dddi,
+  trampoline_d_dddi,
+  trampoline_v_iiif,
+  trampoline_i_iiif,
+  trampoline_f_iiif,
+  trampoline_d_iiif,
+  trampoline_v_fiif,
+  trampoline_i_fiif,
+  trampoline_f_fiif,
+  trampoline_d_fiif,
+  trampoline_v_diif,
+  trampoline_i_diif,
+  trampoline_f_diif,
+  trampoline_d_diif,
+  trampoline_v_ifif,
+  trampoline_i_ifif,
+  trampoline_f_ifif,
+  trampoline_d_ifif,
+  trampoline_v_ffif,
+  trampoline_i_ffif,
+  trampoline_f_ffif,
+  trampoline_d_ffif,
+  trampoline_v_dfif,
+  trampoline_i_dfif,
+  trampoline_f_dfif,
+  trampoline_d_dfif,
+  trampoline_v_idif,
+  trampoline_i_idif,
+  trampoline_f_idif,
+  trampoline_d_idif,
+  trampoline_v_fdif,
+  trampoline_i_fdif,
+  trampoline_f_fdif,
+  trampoline_d_fdif,
+  trampoline_v_ddif,
+  trampoline_i_ddif,
+  trampoline_f_ddif,
+  trampoline_d_ddif,
+  trampoline_v_iiff,
+  trampoline_i_iiff,
+  trampoline_f_iiff,
+  trampoline_d_iiff,
+  trampoline_v_fiff,
+  trampoline_i_fiff,
+  trampoline_f_fiff,
+  trampoline_d_fiff,
+  trampoline_v_diff,
+  trampoline_i_diff,
+  trampoline_f_diff,
+  trampoline_d_diff,
+  trampoline_v_ifff,
+  trampoline_i_ifff,
+  trampoline_f_ifff,
+  trampoline_d_ifff,
+  trampoline_v_ffff,
+  trampoline_i_ffff,
+  trampoline_f_ffff,
+  trampoline_d_ffff,
+  trampoline_v_dfff,
+  trampoline_i_dfff,
+  trampoline_f_dfff,
+  trampoline_d_dfff,
+  trampoline_v_idff,
+  trampoline_i_idff,
+  trampoline_f_idff,
+  trampoline_d_idff,
+  trampoline_v_fdff,
+  trampoline_i_fdff,
+  trampoline_f_fdff,
+  trampoline_d_fdff,
+  trampoline_v_ddff,
+  trampoline_i_ddff,
+  trampoline_f_ddff,
+  trampoline_d_ddff,
+  trampoline_v_iidf,
+  trampoline_i_iidf,
+  trampoline_f_iidf,
+  trampoline_d_iidf,
+  trampoline_v_fidf,
+  trampoline_i_fidf,
+  trampoline_f_fidf,
+  trampoline_d_fidf,
+  trampoline_v_didf,
+  trampoline_i_didf,
+  trampoline_f_didf,
+  trampoline_d_didf,
+  trampoline_v_ifdf,
+  trampoline_i_ifdf,
+  trampoline_f_ifdf,
+  trampoline_d_ifdf,
+  trampoline_v_ffdf,
+  trampoline_i_ffdf,
+  trampoline_f_ffdf,
+  trampoline_d_ffdf,
+  trampoline_v_dfdf,
+  trampoline_i_dfdf,
+  trampoline_f_dfdf,
+  trampoline_d_dfdf,
+  trampoline_v_iddf,
+  trampoline_i_iddf,
+  trampoline_f_iddf,
+  trampoline_d_iddf,
+  trampoline_v_fddf,
+  trampoline_i_fddf,
+  trampoline_f_fddf,
+  trampoline_d_fddf,
+  trampoline_v_dddf,
+  trampoline_i_dddf,
+  trampoline_f_dddf,
+  trampoline_d_dddf,
+  trampoline_v_iiid,
+  trampoline_i_iiid,
+  trampoline_f_iiid,
+  trampoline_d_iiid,
+  trampoline_v_fiid,
+  trampoline_i_fiid,
+  trampoline_f_fiid,
+  trampoline_d_fiid,
+  trampoline_v_diid,
+  trampoline_i_diid,
+  trampoline_f_diid,
+  trampoline_d_diid,
+  trampoline_v_ifid,
+  trampoline_i_ifid,
+  trampoline_f_ifid,
+  trampoline_d_ifid,
+  trampoline_v_ffid,
+  trampoline_i_ffid,
+  trampoline_f_ffid,
+  trampoline_d_ffid,
+  trampoline_v_dfid,
+  trampoline_i_dfid,
+  trampoline_f_dfid,
+  trampoline_d_dfid,
+  trampoline_v_idid,
+  trampoline_i_idid,
+  trampoline_f_idid,
+  trampoline_d_idid,
+  trampoline_v_fdid,
+  trampoline_i_fdid,
+  trampoline_f_fdid,
+  trampoline_d_fdid,
+  trampoline_v_ddid,
+  trampoline_i_ddid,
+  trampoline_f_ddid,
+  trampoline_d_ddid,
+  trampoline_v_iifd,
+  trampoline_i_iifd,
+  trampoline_f_iifd,
+  trampoline_d_iifd,
+  trampoline_v_fifd,
+  trampoline_i_fifd,
+  trampoline_f_fifd,
+  trampoline_d_fifd,
+  trampoline_v_difd,
+  trampoline_i_difd,
+  trampoline_f_difd,
+  trampoline_d_difd,
+  trampoline_v_iffd,
+  trampoline_i_iffd,
+  trampoline_f_iffd,
+  trampoline_d_iffd,
+  trampoline_v_fffd,
+  trampoline_i_fffd,
+  trampoline_f_fffd,
+  trampoline_d_fffd,
+  trampoline_v_dffd,
+  trampoline_i_dffd,
+  trampoline_f_dffd,
+  trampoline_d_dffd,
+  trampoline_v_idfd,
+  trampoline_i_idfd,
+  trampoline_f_idfd,
+  trampoline_d_idfd,
+  trampoline_v_fdfd,
+  trampoline_i_fdfd,
+  trampoline_f_fdfd,
+  trampoline_d_fdfd,
+  trampoline_v_ddfd,
+  trampoline_i_ddfd,
+  trampoline_f_ddfd,
+  trampoline_d_ddfd,
+  trampoline_v_iidd,
+  trampoline_i_iidd,
+  trampoline_f_iidd,
+  trampoline_d_iidd,
+  trampoline_v_fidd,
+  trampoline_i_fidd,
+  trampoline_f_fidd,
+  trampoline_d_fidd,
+  trampoline_v_didd,
+  trampoline_i_didd,
+  trampoline_f_didd,
+  trampoline_d_didd,
+  trampoline_v_ifdd,
+  trampoline_i_ifdd,
+  trampoline_f_ifdd,
+  trampoline_d_ifdd,
+  trampoline_v_ffdd,
+  trampoline_i_ffdd,
+  trampoline_f_ffdd,
+  trampoline_d_ffdd,
+  trampoline_v_dfdd,
+  trampoline_i_dfdd,
+  trampoline_f_dfdd,
+  trampoline_d_dfdd,
+  trampoline_v_iddd,
+  trampoline_i_iddd,
+  trampoline_f_iddd,
+  trampoline_d_iddd,
+  trampoline_v_fddd,
+  trampoline_i_fddd,
+  trampoline_f_fddd,
+  trampoline_d_fddd,
+  trampoline_v_dddd,
+  trampoline_i_dddd,
+  trampoline_f_dddd,
+  trampoline_d_dddd,
+  trampoline_v_iiiii,
+  trampoline_i_iiiii,
+  trampoline_f_iiiii,
+  trampoline_d_iiiii,
+  trampoline_v_fiiii,
+  trampoline_i_fiiii,
+  trampoline_f_fiiii,
+  trampoline_d_fiiii,
+  trampoline_v_diiii,
+  trampoline_i_diiii,
+  trampoline_f_diiii,
+  trampoline_d_diiii,
+  trampoline_v_ifiii,
+  trampoline_i_ifiii,
+  trampoline_f_ifiii,
+  trampoline_d_ifiii,
+  trampoline_v_ffiii,
+  trampoline_i_ffiii,
+  trampoline_f_ffiii,
+  trampoline_d_ffiii,
+  trampoline_v_dfiii,
+  trampoline_i_dfiii,
+  trampoline_f_dfiii,
+  trampoline_d_dfiii,
+  trampoline_v_idiii,
+  trampoline_i_idiii,
+  trampoline_f_idiii,
+  trampoline_d_idiii,
+  trampoline_v_fdiii,
+  trampoline_i_fdiii,
+  trampoline_f_fdiii,
+  trampoline_d_fdiii,
+  trampoline_v_ddiii,
+  trampoline_i_ddiii,
+  trampoline_f_ddiii,
+  trampoline_d_ddiii,
+  trampoline_v_iifii,
+  trampoline_i_iifii,
+  trampoline_f_iifii,
+  trampoline_d_iifii,
+  trampoline_v_fifii,
+  trampoline_i_fifii,
+  trampoline_f_fifii,
+  trampoline_d_fifii,
+  trampoline_v_difii,
+  trampoline_i_difii,
+  trampoline_f_difii,
+  trampoline_d_difii,
+  trampoline_v_iffii,
+  trampoline_i_iffii,
+  trampoline_f_iffii,
+  trampoline_d_iffii,
+  trampoline_v_fffii,
+  trampoline_i_fffii,
+  trampoline_f_fffii,
+  trampoline_d_fffii,
+  trampoline_v_dffii,
+  trampoline_i_dffii,
+  trampoline_f_dffii,
+  trampoline_d_dffii,
+  trampoline_v_idfii,
+  trampoline_i_idfii,
+  trampoline_f_idfii,
+  trampoline_d_idfii,
+  trampoline_v_fdfii,
+  trampoline_i_fdfii,
+  trampoline_f_fdfii,
+  trampoline_d_fdfii,
+  trampoline_v_ddfii,
+  trampoline_i_ddfii,
+  trampoline_f_ddfii,
+  trampoline_d_ddfii,
+  trampoline_v_iidii,
+  trampoline_i_iidii,
+  trampoline_f_iidii,
+  trampoline_d_iidii,
+  trampoline_v_fidii,
+  trampoline_i_fidii,
+  trampoline_f_fidii,
+  trampoline_d_fidii,
+  trampoline_v_didii,
+  trampoline_i_didii,
+  trampoline_f_didii,
+  trampoline_d_didii,
+  trampoline_v_ifdii,
+  trampoline_i_ifdii,
+  trampoline_f_ifdii,
+  trampoline_d_ifdii,
+  trampoline_v_ffdii,
+  trampoline_i_ffdii,
+  trampoline_f_ffdii,
+  trampoline_d_ffdii,
+  trampoline_v_dfdii,
+  trampoline_i_dfdii,
+  trampoline_f_dfdii,
+  trampoline_d_dfdii,
+  trampoline_v_iddii,
+  trampoline_i_iddii,
+  trampoline_f_iddii,
+  trampoline_d_iddii,
+  trampoline_v_fddii,
+  trampoline_i_fddii,
+  trampoline_f_fddii,
+  trampoline_d_fddii,
+  trampoline_v_dddii,
+  trampoline_i_dddii,
+  trampoline_f_dddii,
+  trampoline_d_dddii,
+  trampoline_v_iiifi,
+  trampoline_i_iiifi,
+  trampoline_f_iiifi,
+  trampoline_d_iiifi,
+  trampoline_v_fiifi,
+  trampoline_i_fiifi,
+  trampoline_f_fiifi,
+  trampoline_d_fiifi,
+  trampoline_v_diifi,
+  trampoline_i_diifi,
+  trampoline_f_diifi,
+  trampoline_d_diifi,
+  trampoline_v_ififi,
+  trampoline_i_ififi,
+  trampoline_f_ififi,
+  trampoline_d_ififi,
+  trampoline_v_ffifi,
+  trampoline_i_ffifi,
+  trampoline_f_ffifi,
+  trampoline_d_ffifi,
+  trampoline_v_dfifi,
+  trampoline_i_dfifi,
+  trampoline_f_dfifi,
+  trampoline_d_dfifi,
+  trampoline_v_idifi,
+  trampoline_i_idifi,
+  trampoline_f_idifi,
+  trampoline_d_idifi,
+  trampoline_v_fdifi,
+  trampoline_i_fdifi,
+  trampoline_f_fdifi,
+  trampoline_d_fdifi,
+  trampoline_v_ddifi,
+  trampoline_i_ddifi,
+  trampoline_f_ddifi,
+  trampoline_d_ddifi,
+  trampoline_v_iiffi,
+  trampoline_i_iiffi,
+  trampoline_f_iiffi,
+  trampoline_d_iiffi,
+  trampoline_v_fiffi,
+  trampoline_i_fiffi,
+  trampoline_f_fiffi,
+  trampoline_d_fiffi,
+  trampoline_v_diffi,
+  trampoline_i_diffi,
+  trampoline_f_diffi,
+  trampoline_d_diffi,
+  trampoline_v_ifffi,
+  trampoline_i_ifffi,
+  trampoline_f_ifffi,
+  trampoline_d_ifffi,
+  trampoline_v_ffffi,
+  trampoline_i_ffffi,
+  trampoline_f_ffffi,
+  trampoline_d_ffffi,
+  trampoline_v_dfffi,
+  trampoline_i_dfffi,
+  trampoline_f_dfffi,
+  trampoline_d_dfffi,
+  trampoline_v_idffi,
+  trampoline_i_idffi,
+  trampoline_f_idffi,
+  trampoline_d_idffi,
+  trampoline_v_fdffi,
+  trampoline_i_fdffi,
+  trampoline_f_fdffi,
+  trampoline_d_fdffi,
+  trampoline_v_ddffi,
+  trampoline_i_ddffi,
+  trampoline_f_ddffi,
+  trampoline_d_ddffi,
+  trampoline_v_iidfi,
+  trampoline_i_iidfi,
+  trampoline_f_iidfi,
+  trampoline_d_iidfi,
+  trampoline_v_fidfi,
+  trampoline_i_fidfi,
+  trampoline_f_fidfi,
+  trampoline_d_fidfi,
+  trampoline_v_didfi,
+  trampoline_i_didfi,
+  trampoline_f_didfi,
+  trampoline_d_didfi,
+  trampoline_v_ifdfi,
+  trampoline_i_ifdfi,
+  trampoline_f_ifdfi,
+  trampoline_d_ifdfi,
+  trampoline_v_ffdfi,
+  trampoline_i_ffdfi,
+  trampoline_f_ffdfi,
+  trampoline_d_ffdfi,
+  trampoline_v_dfdfi,
+  trampoline_i_dfdfi,
+  trampoline_f_dfdfi,
+  trampoline_d_dfdfi,
+  trampoline_v_iddfi,
+  trampoline_i_iddfi,
+  trampoline_f_iddfi,
+  trampoline_d_iddfi,
+  trampoline_v_fddfi,
+  trampoline_i_fddfi,
+  trampoline_f_fddfi,
+  trampoline_d_fddfi,
+  trampoline_v_dddfi,
+  trampoline_i_dddfi,
+  trampoline_f_dddfi,
+  trampoline_d_dddfi,
+  trampoline_v_iiidi,
+  trampoline_i_iiidi,
+  trampoline_f_iiidi,
+  trampoline_d_iiidi,
+  trampoline_v_fiidi,
+  trampoline_i_fiidi,
+  trampoline_f_fiidi,
+  trampoline_d_fiidi,
+  trampoline_v_diidi,
+  trampoline_i_diidi,
+  trampoline_f_diidi,
+  trampoline_d_diidi,
+  trampoline_v_ifidi,
+  trampoline_i_ifidi,
+  trampoline_f_ifidi,
+  trampoline_d_ifidi,
+  trampoline_v_ffidi,
+  trampoline_i_ffidi,
+  trampoline_f_ffidi,
+  trampoline_d_ffidi,
+  trampoline_v_dfidi,
+  trampoline_i_dfidi,
+  trampoline_f_dfidi,
+  trampoline_d_dfidi,
+  trampoline_v_ididi,
+  trampoline_i_ididi,
+  trampoline_f_ididi,
+  trampoline_d_ididi,
+  trampoline_v_fdidi,
+  trampoline_i_fdidi,
+  trampoline_f_fdidi,
+  trampoline_d_fdidi,
+  trampoline_v_ddidi,
+  trampoline_i_ddidi,
+  trampoline_f_ddidi,
+  trampoline_d_ddidi,
+  trampoline_v_iifdi,
+  trampoline_i_iifdi,
+  trampoline_f_iifdi,
+  trampoline_d_iifdi,
+  trampoline_v_fifdi,
+  trampoline_i_fifdi,
+  trampoline_f_fifdi,
+  trampoline_d_fifdi,
+  trampoline_v_difdi,
+  trampoline_i_difdi,
+  trampoline_f_difdi,
+  trampoline_d_difdi,
+  trampoline_v_iffdi,
+  trampoline_i_iffdi,
+  trampoline_f_iffdi,
+  trampoline_d_iffdi,
+  trampoline_v_fffdi,
+  trampoline_i_fffdi,
+  trampoline_f_fffdi,
+  trampoline_d_fffdi,
+  trampoline_v_dffdi,
+  trampoline_i_dffdi,
+  trampoline_f_dffdi,
+  trampoline_d_dffdi,
+  trampoline_v_idfdi,
+  trampoline_i_idfdi,
+  trampoline_f_idfdi,
+  trampoline_d_idfdi,
+  trampoline_v_fdfdi,
+  trampoline_i_fdfdi,
+  trampoline_f_fdfdi,
+  trampoline_d_fdfdi,
+  trampoline_v_ddfdi,
+  trampoline_i_ddfdi,
+  trampoline_f_ddfdi,
+  trampoline_d_ddfdi,
+  trampoline_v_iiddi,
+  trampoline_i_iiddi,
+  trampoline_f_iiddi,
+  trampoline_d_iiddi,
+  trampoline_v_fiddi,
+  trampoline_i_fiddi,
+  trampoline_f_fiddi,
+  trampoline_d_fiddi,
+  trampoline_v_diddi,
+  trampoline_i_diddi,
+  trampoline_f_diddi,
+  trampoline_d_diddi,
+  trampoline_v_ifddi,
+  trampoline_i_ifddi,
+  trampoline_f_ifddi,
+  trampoline_d_ifddi,
+  trampoline_v_ffddi,
+  trampoline_i_ffddi,
+  trampoline_f_ffddi,
+  trampoline_d_ffddi,
+  trampoline_v_dfddi,
+  trampoline_i_dfddi,
+  trampoline_f_dfddi,
+  trampoline_d_dfddi,
+  trampoline_v_idddi,
+  trampoline_i_idddi,
+  trampoline_f_idddi,
+  trampoline_d_idddi,
+  trampoline_v_fdddi,
+  trampoline_i_fdddi,
+  trampoline_f_fdddi,
+  trampoline_d_fdddi,
+  trampoline_v_ddddi,
+  trampoline_i_ddddi,
+  trampoline_f_ddddi,
+  trampoline_d_ddddi,
+  trampoline_v_iiiif,
+  trampoline_i_iiiif,
+  trampoline_f_iiiif,
+  trampoline_d_iiiif,
+  trampoline_v_fiiif,
+  trampoline_i_fiiif,
+  trampoline_f_fiiif,
+  trampoline_d_fiiif,
+  trampoline_v_diiif,
+  trampoline_i_diiif,
+  trampoline_f_diiif,
+  trampoline_d_diiif,
+  trampoline_v_ifiif,
+  trampoline_i_ifiif,
+  trampoline_f_ifiif,
+  trampoline_d_ifiif,
+  trampoline_v_ffiif,
+  trampoline_i_ffiif,
+  trampoline_f_ffiif,
+  trampoline_d_ffiif,
+  trampoline_v_dfiif,
+  trampoline_i_dfiif,
+  trampoline_f_dfiif,
+  trampoline_d_dfiif,
+  trampoline_v_idiif,
+  trampoline_i_idiif,
+  trampoline_f_idiif,
+  trampoline_d_idiif,
+  trampoline_v_fdiif,
+  trampoline_i_fdiif,
+  trampoline_f_fdiif,
+  trampoline_d_fdiif,
+  trampoline_v_ddiif,
+  trampoline_i_ddiif,
+  trampoline_f_ddiif,
+  trampoline_d_ddiif,
+  trampoline_v_iifif,
+  trampoline_i_iifif,
+  trampoline_f_iifif,
+  trampoline_d_iifif,
+  trampoline_v_fifif,
+  trampoline_i_fifif,
+  trampoline_f_fifif,
+  trampoline_d_fifif,
+  trampoline_v_difif,
+  trampoline_i_difif,
+  trampoline_f_difif,
+  trampoline_d_difif,
+  trampoline_v_iffif,
+  trampoline_i_iffif,
+  trampoline_f_iffif,
+  trampoline_d_iffif,
+  trampoline_v_fffif,
+  trampoline_i_fffif,
+  trampoline_f_fffif,
+  trampoline_d_fffif,
+  trampoline_v_dffif,
+  trampoline_i_dffif,
+  trampoline_f_dffif,
+  trampoline_d_dffif,
+  trampoline_v_idfif,
+  trampoline_i_idfif,
+  trampoline_f_idfif,
+  trampoline_d_idfif,
+  trampoline_v_fdfif,
+  trampoline_i_fdfif,
+  trampoline_f_fdfif,
+  trampoline_d_fdfif,
+  trampoline_v_ddfif,
+  trampoline_i_ddfif,
+  trampoline_f_ddfif,
+  trampoline_d_ddfif,
+  trampoline_v_iidif,
+  trampoline_i_iidif,
+  trampoline_f_iidif,
+  trampoline_d_iidif,
+  trampoline_v_fidif,
+  trampoline_i_fidif,
+  trampoline_f_fidif,
+  trampoline_d_fidif,
+  trampoline_v_didif,
+  trampoline_i_didif,
+  trampoline_f_didif,
+  trampoline_d_didif,
+  trampoline_v_ifdif,
+  trampoline_i_ifdif,
+  trampoline_f_ifdif,
+  trampoline_d_ifdif,
+  trampoline_v_ffdif,
+  trampoline_i_ffdif,
+  trampoline_f_ffdif,
+  trampoline_d_ffdif,
+  trampoline_v_dfdif,
+  trampoline_i_dfdif,
+  trampoline_f_dfdif,
+  trampoline_d_dfdif,
+  trampoline_v_iddif,
+  trampoline_i_iddif,
+  trampoline_f_iddif,
+  trampoline_d_iddif,
+  trampoline_v_fddif,
+  trampoline_i_fddif,
+  trampoline_f_fddif,
+  trampoline_d_fddif,
+  trampoline_v_dddif,
+  trampoline_i_dddif,
+  trampoline_f_dddif,
+  trampoline_d_dddif,
+  trampoline_v_iiiff,
+  trampoline_i_iiiff,
+  trampoline_f_iiiff,
+  trampoline_d_iiiff,
+  trampoline_v_fiiff,
+  trampoline_i_fiiff,
+  trampoline_f_fiiff,
+  trampoline_d_fiiff,
+  trampoline_v_diiff,
+  trampoline_i_diiff,
+  trampoline_f_diiff,
+  trampoline_d_diiff,
+  trampoline_v_ififf,
+  trampoline_i_ififf,
+  trampoline_f_ififf,
+  trampoline_d_ififf,
+  trampoline_v_ffiff,
+  trampoline_i_ffiff,
+  trampoline_f_ffiff,
+  trampoline_d_ffiff,
+  trampoline_v_dfiff,
+  trampoline_i_dfiff,
+  trampoline_f_dfiff,
+  trampoline_d_dfiff,
+  trampoline_v_idiff,
+  trampoline_i_idiff,
+  trampoline_f_idiff,
+  trampoline_d_idiff,
+  trampoline_v_fdiff,
+  trampoline_i_fdiff,
+  trampoline_f_fdiff,
+  trampoline_d_fdiff,
+  trampoline_v_ddiff,
+  trampoline_i_ddiff,
+  trampoline_f_ddiff,
+  trampoline_d_ddiff,
+  trampoline_v_iifff,
+  trampoline_i_iifff,
+  trampoline_f_iifff,
+  trampoline_d_iifff,
+  trampoline_v_fifff,
+  trampoline_i_fifff,
+  trampoline_f_fifff,
+  trampoline_d_fifff,
+  trampoline_v_difff,
+  trampoline_i_difff,
+  trampoline_f_difff,
+  trampoline_d_difff,
+  trampoline_v_iffff,
+  trampoline_i_iffff,
+  trampoline_f_iffff,
+  trampoline_d_iffff,
+  trampoline_v_fffff,
+  trampoline_i_fffff,
+  trampoline_f_fffff,
+  trampoline_d_fffff,
+  trampoline_v_dffff,
+  trampoline_i_dffff,
+  trampoline_f_dffff,
+  trampoline_d_dffff,
+  trampoline_v_idfff,
+  trampoline_i_idfff,
+  trampoline_f_idfff,
+  trampoline_d_idfff,
+  trampoline_v_fdfff,
+  trampoline_i_fdfff,
+  trampoline_f_fdfff,
+  trampoline_d_fdfff,
+  trampoline_v_ddfff,
+  trampoline_i_ddfff,
+  trampoline_f_ddfff,
+  trampoline_d_ddfff,
+  trampoline_v_iidff,
+  trampoline_i_iidff,
+  trampoline_f_iidff,
+  trampoline_d_iidff,
+  trampoline_v_fidff,
+  trampoline_i_fidff,
+  trampoline_f_fidff,
+  trampoline_d_fidff,
+  trampoline_v_didff,
+  trampoline_i_didff,
+  trampoline_f_didff,
+  trampoline_d_didff,
+  trampoline_v_ifdff,
+  trampoline_i_ifdff,
+  trampoline_f_ifdff,
+  trampoline_d_ifdff,
+  trampoline_v_ffdff,
+  trampoline_i_ffdff,
+  trampoline_f_ffdff,
+  trampoline_d_ffdff,
+  trampoline_v_dfdff,
+  trampoline_i_dfdff,
+  trampoline_f_dfdff,
+  trampoline_d_dfdff,
+  trampoline_v_iddff,
+  trampoline_i_iddff,
+  trampoline_f_iddff,
+  trampoline_d_iddff,
+  trampoline_v_fddff,
+  trampoline_i_fddff,
+  trampoline_f_fddff,
+  trampoline_d_fddff,
+  trampoline_v_dddff,
+  trampoline_i_dddff,
+  trampoline_f_dddff,
+  trampoline_d_dddff,
+  trampoline_v_iiidf,
+  trampoline_i_iiidf,
+  trampoline_f_iiidf,
+  trampoline_d_iiidf,
+  trampoline_v_fiidf,
+  trampoline_i_fiidf,
+  trampoline_f_fiidf,
+  trampoline_d_fiidf,
+  trampoline_v_diidf,
+  trampoline_i_diidf,
+  trampoline_f_diidf,
+  trampoline_d_diidf,
+  trampoline_v_ifidf,
+  trampoline_i_ifidf,
+  trampoline_f_ifidf,
+  trampoline_d_ifidf,
+  trampoline_v_ffidf,
+  trampoline_i_ffidf,
+  trampoline_f_ffidf,
+  trampoline_d_ffidf,
+  trampoline_v_dfidf,
+  trampoline_i_dfidf,
+  trampoline_f_dfidf,
+  trampoline_d_dfidf,
+  trampoline_v_ididf,
+  trampoline_i_ididf,
+  trampoline_f_ididf,
+  trampoline_d_ididf,
+  trampoline_v_fdidf,
+  trampoline_i_fdidf,
+  trampoline_f_fdidf,
+  trampoline_d_fdidf,
+  trampoline_v_ddidf,
+  trampoline_i_ddidf,
+  trampoline_f_ddidf,
+  trampoline_d_ddidf,
+  trampoline_v_iifdf,
+  trampoline_i_iifdf,
+  trampoline_f_iifdf,
+  trampoline_d_iifdf,
+  trampoline_v_fifdf,
+  trampoline_i_fifdf,
+  trampoline_f_fifdf,
+  trampoline_d_fifdf,
+  trampoline_v_difdf,
+  trampoline_i_difdf,
+  trampoline_f_difdf,
+  trampoline_d_difdf,
+  trampoline_v_iffdf,
+  trampoline_i_iffdf,
+  trampoline_f_iffdf,
+  trampoline_d_iffdf,
+  trampoline_v_fffdf,
+  trampoline_i_fffdf,
+  trampoline_f_fffdf,
+  trampoline_d_fffdf,
+  trampoline_v_dffdf,
+  trampoline_i_dffdf,
+  trampoline_f_dffdf,
+  trampoline_d_dffdf,
+  trampoline_v_idfdf,
+  trampoline_i_idfdf,
+  trampoline_f_idfdf,
+  trampoline_d_idfdf,
+  trampoline_v_fdfdf,
+  trampoline_i_fdfdf,
+  trampoline_f_fdfdf,
+  trampoline_d_fdfdf,
+  trampoline_v_ddfdf,
+  trampoline_i_ddfdf,
+  trampoline_f_ddfdf,
+  trampoline_d_ddfdf,
+  trampoline_v_iiddf,
+  trampoline_i_iiddf,
+  trampoline_f_iiddf,
+  trampoline_d_iiddf,
+  trampoline_v_fiddf,
+  trampoline_i_fiddf,
+  trampoline_f_fiddf,
+  trampoline_d_fiddf,
+  trampoline_v_diddf,
+  trampoline_i_diddf,
+  trampoline_f_diddf,
+  trampoline_d_diddf,
+  trampoline_v_ifddf,
+  trampoline_i_ifddf,
+  trampoline_f_ifddf,
+  trampoline_d_ifddf,
+  trampoline_v_ffddf,
+  trampoline_i_ffddf,
+  trampoline_f_ffddf,
+  trampoline_d_ffddf,
+  trampoline_v_dfddf,
+  trampoline_i_dfddf,
+  trampoline_f_dfddf,
+  trampoline_d_dfddf,
+  trampoline_v_idddf,
+  trampoline_i_idddf,
+  trampoline_f_idddf,
+  trampoline_d_idddf,
+  trampoline_v_fdddf,
+  trampoline_i_fdddf,
+  trampoline_f_fdddf,
+  trampoline_d_fdddf,
+  trampoline_v_ddddf,
+  trampoline_i_ddddf,
+  trampoline_f_ddddf,
+  trampoline_d_ddddf,
+  trampoline_v_iiiid,
+  trampoline_i_iiiid,
+  trampoline_f_iiiid,
+  trampoline_d_iiiid,
+  trampoline_v_fiiid,
+  trampoline_i_fiiid,
+  trampoline_f_fiiid,
+  trampoline_d_fiiid,
+  trampoline_v_diiid,
+  trampoline_i_diiid,
+  trampoline_f_diiid,
+  trampoline_d_diiid,
+  trampoline_v_ifiid,
+  trampoline_i_ifiid,
+  trampoline_f_ifiid,
+  trampoline_d_ifiid,
+  trampoline_v_ffiid,
+  trampoline_i_ffiid,
+  trampoline_f_ffiid,
+  trampoline_d_ffiid,
+  trampoline_v_dfiid,
+  trampoline_i_dfiid,
+  trampoline_f_dfiid,
+  trampoline_d_dfiid,
+  trampoline_v_idiid,
+  trampoline_i_idiid,
+  trampoline_f_idiid,
+  trampoline_d_idiid,
+  trampoline_v_fdiid,
+  trampoline_i_fdiid,
+  trampoline_f_fdiid,
+  trampoline_d_fdiid,
+  trampoline_v_ddiid,
+  trampoline_i_ddiid,
+  trampoline_f_ddiid,
+  trampoline_d_ddiid,
+  trampoline_v_iifid,
+  trampoline_i_iifid,
+  trampoline_f_iifid,
+  trampoline_d_iifid,
+  trampoline_v_fifid,
+  trampoline_i_fifid,
+  trampoline_f_fifid,
+  trampoline_d_fifid,
+  trampoline_v_difid,
+  trampoline_i_difid,
+  trampoline_f_difid,
+  trampoline_d_difid,
+  trampoline_v_iffid,
+  trampoline_i_iffid,
+  trampoline_f_iffid,
+  trampoline_d_iffid,
+  trampoline_v_fffid,
+  trampoline_i_fffid,
+  trampoline_f_fffid,
+  trampoline_d_fffid,
+  trampoline_v_dffid,
+  trampoline_i_dffid,
+  trampoline_f_dffid,
+  trampoline_d_dffid,
+  trampoline_v_idfid,
+  trampoline_i_idfid,
+  trampoline_f_idfid,
+  trampoline_d_idfid,
+  trampoline_v_fdfid,
+  trampoline_i_fdfid,
+  trampoline_f_fdfid,
+  trampoline_d_fdfid,
+  trampoline_v_ddfid,
+  trampoline_i_ddfid,
+  trampoline_f_ddfid,
+  trampoline_d_ddfid,
+  trampoline_v_iidid,
+  trampoline_i_iidid,
+  trampoline_f_iidid,
+  trampoline_d_iidid,
+  trampoline_v_fidid,
+  trampoline_i_fidid,
+  trampoline_f_fidid,
+  trampoline_d_fidid,
+  trampoline_v_didid,
+  trampoline_i_didid,
+  trampoline_f_didid,
+  trampoline_d_didid,
+  trampoline_v_ifdid,
+  trampoline_i_ifdid,
+  trampoline_f_ifdid,
+  trampoline_d_ifdid,
+  trampoline_v_ffdid,
+  trampoline_i_ffdid,
+  trampoline_f_ffdid,
+  trampoline_d_ffdid,
+  trampoline_v_dfdid,
+  trampoline_i_dfdid,
+  trampoline_f_dfdid,
+  trampoline_d_dfdid,
+  trampoline_v_iddid,
+  trampoline_i_iddid,
+  trampoline_f_iddid,
+  trampoline_d_iddid,
+  trampoline_v_fddid,
+  trampoline_i_fddid,
+  trampoline_f_fddid,
+  trampoline_d_fddid,
+  trampoline_v_dddid,
+  trampoline_i_dddid,
+  trampoline_f_dddid,
+  trampoline_d_dddid,
+  trampoline_v_iiifd,
+  trampoline_i_iiifd,
+  trampoline_f_iiifd,
+  trampoline_d_iiifd,
+  trampoline_v_fiifd,
+  trampoline_i_fiifd,
+  trampoline_f_fiifd,
+  trampoline_d_fiifd,
+  trampoline_v_diifd,
+  trampoline_i_diifd,
+  trampoline_f_diifd,
+  trampoline_d_diifd,
+  trampoline_v_ififd,
+  trampoline_i_ififd,
+  trampoline_f_ififd,
+  trampoline_d_ififd,
+  trampoline_v_ffifd,
+  trampoline_i_ffifd,
+  trampoline_f_ffifd,
+  trampoline_d_ffifd,
+  trampoline_v_dfifd,
+  trampoline_i_dfifd,
+  trampoline_f_dfifd,
+  trampoline_d_dfifd,
+  trampoline_v_idifd,
+  trampoline_i_idifd,
+  trampoline_f_idifd,
+  trampoline_d_idifd,
+  trampoline_v_fdifd,
+  trampoline_i_fdifd,
+  trampoline_f_fdifd,
+  trampoline_d_fdifd,
+  trampoline_v_ddifd,
+  trampoline_i_ddifd,
+  trampoline_f_ddifd,
+  trampoline_d_ddifd,
+  trampoline_v_iiffd,
+  trampoline_i_iiffd,
+  trampoline_f_iiffd,
+  trampoline_d_iiffd,
+  trampoline_v_fiffd,
+  trampoline_i_fiffd,
+  trampoline_f_fiffd,
+  trampoline_d_fiffd,
+  trampoline_v_diffd,
+  trampoline_i_diffd,
+  trampoline_f_diffd,
+  trampoline_d_diffd,
+  trampoline_v_ifffd,
+  trampoline_i_ifffd,
+  trampoline_f_ifffd,
+  trampoline_d_ifffd,
+  trampoline_v_ffffd,
+  trampoline_i_ffffd,
+  trampoline_f_ffffd,
+  trampoline_d_ffffd,
+  trampoline_v_dfffd,
+  trampoline_i_dfffd,
+  trampoline_f_dfffd,
+  trampoline_d_dfffd,
+  trampoline_v_idffd,
+  trampoline_i_idffd,
+  trampoline_f_idffd,
+  trampoline_d_idffd,
+  trampoline_v_fdffd,
+  trampoline_i_fdffd,
+  trampoline_f_fdffd,
+  trampoline_d_fdffd,
+  trampoline_v_ddffd,
+  trampoline_i_ddffd,
+  trampoline_f_ddffd,
+  trampoline_d_ddffd,
+  trampoline_v_iidfd,
+  trampoline_i_iidfd,
+  trampoline_f_iidfd,
+  trampoline_d_iidfd,
+  trampoline_v_fidfd,
+  trampoline_i_fidfd,
+  trampoline_f_fidfd,
+  trampoline_d_fidfd,
+  trampoline_v_didfd,
+  trampoline_i_didfd,
+  trampoline_f_didfd,
+  trampoline_d_didfd,
+  trampoline_v_ifdfd,
+  trampoline_i_ifdfd,
+  trampoline_f_ifdfd,
+  trampoline_d_ifdfd,
+  trampoline_v_ffdfd,
+  trampoline_i_ffdfd,
+  trampoline_f_ffdfd,
+  trampoline_d_ffdfd,
+  trampoline_v_dfdfd,
+  trampoline_i_dfdfd,
+  trampoline_f_dfdfd,
+  trampoline_d_dfdfd,
+  trampoline_v_iddfd,
+  trampoline_i_iddfd,
+  trampoline_f_iddfd,
+  trampoline_d_iddfd,
+  trampoline_v_fddfd,
+  trampoline_i_fddfd,
+  trampoline_f_fddfd,
+  trampoline_d_fddfd,
+  trampoline_v_dddfd,
+  trampoline_i_dddfd,
+  trampoline_f_dddfd,
+  trampoline_d_dddfd,
+  trampoline_v_iiidd,
+  trampoline_i_iiidd,
+  trampoline_f_iiidd,
+  trampoline_d_iiidd,
+  trampoline_v_fiidd,
+  trampoline_i_fiidd,
+  trampoline_f_fiidd,
+  trampoline_d_fiidd,
+  trampoline_v_diidd,
+  trampoline_i_diidd,
+  trampoline_f_diidd,
+  trampoline_d_diidd,
+  trampoline_v_ifidd,
+  trampoline_i_ifidd,
+  trampoline_f_ifidd,
+  trampoline_d_ifidd,
+  trampoline_v_ffidd,
+  trampoline_i_ffidd,
+  trampoline_f_ffidd,
+  trampoline_d_ffidd,
+  trampoline_v_dfidd,
+  trampoline_i_dfidd,
+  trampoline_f_dfidd,
+  trampoline_d_dfidd,
+  trampoline_v_ididd,
+  trampoline_i_ididd,
+  trampoline_f_ididd,
+  trampoline_d_ididd,
+  trampoline_v_fdidd,
+  trampoline_i_fdidd,
+  trampoline_f_fdidd,
+  trampoline_d_fdidd,
+  trampoline_v_ddidd,
+  trampoline_i_ddidd,
+  trampoline_f_ddidd,
+  trampoline_d_ddidd,
+  trampoline_v_iifdd,
+  trampoline_i_iifdd,
+  trampoline_f_iifdd,
+  trampoline_d_iifdd,
+  trampoline_v_fifdd,
+  trampoline_i_fifdd,
+  trampoline_f_fifdd,
+  trampoline_d_fifdd,
+  trampoline_v_difdd,
+  trampoline_i_difdd,
+  trampoline_f_difdd,
+  trampoline_d_difdd,
+  trampoline_v_iffdd,
+  trampoline_i_iffdd,
+  trampoline_f_iffdd,
+  trampoline_d_iffdd,
+  trampoline_v_fffdd,
+  trampoline_i_fffdd,
+  trampoline_f_fffdd,
+  trampoline_d_fffdd,
+  trampoline_v_dffdd,
+  trampoline_i_dffdd,
+  trampoline_f_dffdd,
+  trampoline_d_dffdd,
+  trampoline_v_idfdd,
+  trampoline_i_idfdd,
+  trampoline_f_idfdd,
+  trampoline_d_idfdd,
+  trampoline_v_fdfdd,
+  trampoline_i_fdfdd,
+  trampoline_f_fdfdd,
+  trampoline_d_fdfdd,
+  trampoline_v_ddfdd,
+  trampoline_i_ddfdd,
+  trampoline_f_ddfdd,
+  trampoline_d_ddfdd,
+  trampoline_v_iiddd,
+  trampoline_i_iiddd,
+  trampoline_f_iiddd,
+  trampoline_d_iiddd,
+  trampoline_v_fiddd,
+  trampoline_i_fiddd,
+  trampoline_f_fiddd,
+  trampoline_d_fiddd,
+  trampoline_v_diddd,
+  trampoline_i_diddd,
+  trampoline_f_diddd,
+  trampoline_d_diddd,
+  trampoline_v_ifddd,
+  trampoline_i_ifddd,
+  trampoline_f_ifddd,
+  trampoline_d_ifddd,
+  trampoline_v_ffddd,
+  trampoline_i_ffddd,
+  trampoline_f_ffddd,
+  trampoline_d_ffddd,
+  trampoline_v_dfddd,
+  trampoline_i_dfddd,
+  trampoline_f_dfddd,
+  trampoline_d_dfddd,
+  trampoline_v_idddd,
+  trampoline_i_idddd,
+  trampoline_f_idddd,
+  trampoline_d_idddd,
+  trampoline_v_fdddd,
+  trampoline_i_fdddd,
+  trampoline_f_fdddd,
+  trampoline_d_fdddd,
+  trampoline_v_ddddd,
+  trampoline_i_ddddd,
+  trampoline_f_ddddd,
+  trampoline_d_ddddd,
+};
+
+void* callDelegate(void* f, int index, const uint64_t* args, int exceptionArg, uint64_t* result) {
+  if (exceptionArg < 0) {
+    trampolines[index](f, args, result);
+    return NULL;
+  }
+  uint64_t a[DELEGATE_NATIVE_ARITY];
+  void* exception = NULL;
+  memcpy(a, args, sizeof(a));
+  a[exceptionArg] = (uint64_t)(uintptr_t)&exception;
+  trampolines[index](f, a, result);
+  return exception;
+}
+
+static void callback_v_0(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(0, ints, floats, &r);
+}
+
+static void callback_v_1(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(1, ints, floats, &r);
+}
+
+static void callback_v_2(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(2, ints, floats, &r);
+}
+
+static void callback_v_3(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(3, ints, floats, &r);
+}
+
+static void callback_v_4(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(4, ints, floats, &r);
+}
+
+static void callback_v_5(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(5, ints, floats, &r);
+}
+
+static void callback_v_6(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(6, ints, floats, &r);
+}
+
+static void callback_v_7(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(7, ints, floats, &r);
+}
+
+static void callback_v_8(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(8, ints, floats, &r);
+}
+
+static void callback_v_9(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(9, ints, floats, &r);
+}
+
+static void callback_v_10(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(10, ints, floats, &r);
+}
+
+static void callback_v_11(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(11, ints, floats, &r);
+}
+
+static void callback_v_12(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(12, ints, floats, &r);
+}
+
+static void callback_v_13(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(13, ints, floats, &r);
+}
+
+static void callback_v_14(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(14, ints, floats, &r);
+}
+
+static void callback_v_15(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(15, ints, floats, &r);
+}
+
+static void callback_v_16(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(16, ints, floats, &r);
+}
+
+static void callback_v_17(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(17, ints, floats, &r);
+}
+
+static void callback_v_18(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(18, ints, floats, &r);
+}
+
+static void callback_v_19(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(19, ints, floats, &r);
+}
+
+static void callback_v_20(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(20, ints, floats, &r);
+}
+
+static void callback_v_21(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(21, ints, floats, &r);
+}
+
+static void callback_v_22(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(22, ints, floats, &r);
+}
+
+static void callback_v_23(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(23, ints, floats, &r);
+}
+
+static void callback_v_24(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(24, ints, floats, &r);
+}
+
+static void callback_v_25(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(25, ints, floats, &r);
+}
+
+static void callback_v_26(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(26, ints, floats, &r);
+}
+
+static void callback_v_27(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(27, ints, floats, &r);
+}
+
+static void callback_v_28(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(28, ints, floats, &r);
+}
+
+static void callback_v_29(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(29, ints, floats, &r);
+}
+
+static void callback_v_30(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(30, ints, floats, &r);
+}
+
+static void callback_v_31(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(31, ints, floats, &r);
+}
+
+static void callback_v_32(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(32, ints, floats, &r);
+}
+
+static void callback_v_33(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(33, ints, floats, &r);
+}
+
+static void callback_v_34(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(34, ints, floats, &r);
+}
+
+static void callback_v_35(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(35, ints, floats, &r);
+}
+
+static void callback_v_36(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(36, ints, floats, &r);
+}
+
+static void callback_v_37(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(37, ints, floats, &r);
+}
+
+static void callback_v_38(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(38, ints, floats, &r);
+}
+
+static void callback_v_39(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(39, ints, floats, &r);
+}
+
+static void callback_v_40(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(40, ints, floats, &r);
+}
+
+static void callback_v_41(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(41, ints, floats, &r);
+}
+
+static void callback_v_42(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(42, ints, floats, &r);
+}
+
+static void callback_v_43(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(43, ints, floats, &r);
+}
+
+static void callback_v_44(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(44, ints, floats, &r);
+}
+
+static void callback_v_45(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(45, ints, floats, &r);
+}
+
+static void callback_v_46(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(46, ints, floats, &r);
+}
+
+static void callback_v_47(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(47, ints, floats, &r);
+}
+
+static void callback_v_48(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(48, ints, floats, &r);
+}
+
+static void callback_v_49(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(49, ints, floats, &r);
+}
+
+static void callback_v_50(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(50, ints, floats, &r);
+}
+
+static void callback_v_51(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(51, ints, floats, &r);
+}
+
+static void callback_v_52(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(52, ints, floats, &r);
+}
+
+static void callback_v_53(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(53, ints, floats, &r);
+}
+
+static void callback_v_54(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(54, ints, floats, &r);
+}
+
+static void callback_v_55(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(55, ints, floats, &r);
+}
+
+static void callback_v_56(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(56, ints, floats, &r);
+}
+
+static void callback_v_57(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(57, ints, floats, &r);
+}
+
+static void callback_v_58(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(58, ints, floats, &r);
+}
+
+static void callback_v_59(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(59, ints, floats, &r);
+}
+
+static void callback_v_60(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(60, ints, floats, &r);
+}
+
+static void callback_v_61(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(61, ints, floats, &r);
+}
+
+static void callback_v_62(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(62, ints, floats, &r);
+}
+
+static void callback_v_63(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(63, ints, floats, &r);
+}
+
+static void callback_v_64(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(64, ints, floats, &r);
+}
+
+static void callback_v_65(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(65, ints, floats, &r);
+}
+
+static void callback_v_66(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(66, ints, floats, &r);
+}
+
+static void callback_v_67(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(67, ints, floats, &r);
+}
+
+static void callback_v_68(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(68, ints, floats, &r);
+}
+
+static void callback_v_69(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(69, ints, floats, &r);
+}
+
+static void callback_v_70(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(70, ints, floats, &r);
+}
+
+static void callback_v_71(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(71, ints, floats, &r);
+}
+
+static void callback_v_72(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(72, ints, floats, &r);
+}
+
+static void callback_v_73(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(73, ints, floats, &r);
+}
+
+static void callback_v_74(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(74, ints, floats, &r);
+}
+
+static void callback_v_75(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(75, ints, floats, &r);
+}
+
+static void callback_v_76(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(76, ints, floats, &r);
+}
+
+static void callback_v_77(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(77, ints, floats, &r);
+}
+
+static void callback_v_78(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(78, ints, floats, &r);
+}
+
+static void callback_v_79(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(79, ints, floats, &r);
+}
+
+static void callback_v_80(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(80, ints, floats, &r);
+}
+
+static void callback_v_81(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(81, ints, floats, &r);
+}
+
+static void callback_v_82(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(82, ints, floats, &r);
+}
+
+static void callback_v_83(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(83, ints, floats, &r);
+}
+
+static void callback_v_84(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(84, ints, floats, &r);
+}
+
+static void callback_v_85(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(85, ints, floats, &r);
+}
+
+static void callback_v_86(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(86, ints, floats, &r);
+}
+
+static void callback_v_87(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(87, ints, floats, &r);
+}
+
+static void callback_v_88(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(88, ints, floats, &r);
+}
+
+static void callback_v_89(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(89, ints, floats, &r);
+}
+
+static void callback_v_90(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(90, ints, floats, &r);
+}
+
+static void callback_v_91(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(91, ints, floats, &r);
+}
+
+static void callback_v_92(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(92, ints, floats, &r);
+}
+
+static void callback_v_93(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(93, ints, floats, &r);
+}
+
+static void callback_v_94(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(94, ints, floats, &r);
+}
+
+static void callback_v_95(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(95, ints, floats, &r);
+}
+
+static void callback_v_96(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(96, ints, floats, &r);
+}
+
+static void callback_v_97(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(97, ints, floats, &r);
+}
+
+static void callback_v_98(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(98, ints, floats, &r);
+}
+
+static void callback_v_99(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(99, ints, floats, &r);
+}
+
+static void callback_v_100(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(100, ints, floats, &r);
+}
+
+static void callback_v_101(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(101, ints, floats, &r);
+}
+
+static void callback_v_102(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(102, ints, floats, &r);
+}
+
+static void callback_v_103(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(103, ints, floats, &r);
+}
+
+static void callback_v_104(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(104, ints, floats, &r);
+}
+
+static void callback_v_105(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(105, ints, floats, &r);
+}
+
+static void callback_v_106(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(106, ints, floats, &r);
+}
+
+static void callback_v_107(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(107, ints, floats, &r);
+}
+
+static void callback_v_108(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(108, ints, floats, &r);
+}
+
+static void callback_v_109(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(109, ints, floats, &r);
+}
+
+static void callback_v_110(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(110, ints, floats, &r);
+}
+
+static void callback_v_111(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(111, ints, floats, &r);
+}
+
+static void callback_v_112(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(112, ints, floats, &r);
+}
+
+static void callback_v_113(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(113, ints, floats, &r);
+}
+
+static void callback_v_114(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(114, ints, floats, &r);
+}
+
+static void callback_v_115(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(115, ints, floats, &r);
+}
+
+static void callback_v_116(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(116, ints, floats, &r);
+}
+
+static void callback_v_117(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(117, ints, floats, &r);
+}
+
+static void callback_v_118(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(118, ints, floats, &r);
+}
+
+static void callback_v_119(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(119, ints, floats, &r);
+}
+
+static void callback_v_120(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(120, ints, floats, &r);
+}
+
+static void callback_v_121(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(121, ints, floats, &r);
+}
+
+static void callback_v_122(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(122, ints, floats, &r);
+}
+
+static void callback_v_123(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(123, ints, floats, &r);
+}
+
+static void callback_v_124(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(124, ints, floats, &r);
+}
+
+static void callback_v_125(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(125, ints, floats, &r);
+}
+
+static void callback_v_126(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(126, ints, floats, &r);
+}
+
+static void callback_v_127(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(127, ints, floats, &r);
+}
+
+static int64_t callback_i_0(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(0, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_1(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(1, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_2(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(2, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_3(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(3, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_4(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(4, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_5(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(5, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_6(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(6, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_7(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(7, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_8(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(8, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_9(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(9, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_10(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(10, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_11(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(11, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_12(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(12, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_13(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(13, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_14(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(14, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_15(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(15, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_16(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(16, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_17(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(17, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_18(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(18, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_19(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(19, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_20(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(20, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_21(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(21, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_22(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(22, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_23(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(23, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_24(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(24, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_25(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(25, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_26(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(26, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_27(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(27, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_28(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(28, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_29(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(29, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_30(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(30, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_31(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(31, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_32(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(32, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_33(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(33, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_34(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(34, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_35(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(35, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_36(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(36, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_37(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(37, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_38(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(38, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_39(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(39, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_40(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(40, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_41(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(41, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_42(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(42, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_43(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(43, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_44(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(44, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_45(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(45, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_46(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(46, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_47(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(47, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_48(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(48, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_49(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(49, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_50(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(50, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_51(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(51, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_52(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(52, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_53(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(53, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_54(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(54, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_55(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(55, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_56(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(56, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_57(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(57, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_58(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(58, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_59(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(59, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_60(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(60, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_61(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(61, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_62(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(62, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_63(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(63, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_64(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(64, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_65(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(65, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_66(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(66, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_67(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(67, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_68(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(68, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_69(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(69, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_70(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(70, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_71(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(71, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_72(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(72, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_73(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(73, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_74(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(74, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_75(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(75, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_76(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(76, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_77(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(77, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_78(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(78, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_79(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(79, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_80(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(80, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_81(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(81, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_82(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(82, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_83(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(83, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_84(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(84, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_85(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(85, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_86(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(86, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_87(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(87, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_88(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(88, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_89(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(89, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_90(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(90, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_91(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(91, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_92(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(92, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_93(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(93, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_94(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(94, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_95(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(95, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_96(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(96, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_97(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(97, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_98(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(98, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_99(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(99, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_100(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(100, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_101(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(101, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_102(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(102, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_103(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(103, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_104(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(104, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_105(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(105, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_106(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(106, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_107(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(107, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_108(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(108, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_109(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(109, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_110(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(110, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_111(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(111, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_112(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(112, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_113(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(113, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_114(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(114, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_115(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(115, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_116(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(116, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_117(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(117, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_118(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(118, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_119(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(119, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_120(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(120, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_121(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(121, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_122(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(122, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_123(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(123, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_124(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(124, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_125(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(125, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_126(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(126, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static int64_t callback_i_127(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(127, ints, floats, &r);
+  return (int64_t)r;
+}
+
+static float callback_f_0(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(0, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_1(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(1, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_2(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(2, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_3(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(3, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_4(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(4, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_5(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(5, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_6(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(6, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_7(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(7, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_8(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(8, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_9(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(9, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_10(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(10, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_11(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(11, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_12(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(12, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_13(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(13, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_14(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(14, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_15(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(15, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_16(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(16, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_17(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(17, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_18(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(18, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_19(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(19, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_20(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(20, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_21(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(21, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_22(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(22, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_23(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(23, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_24(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(24, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_25(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(25, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_26(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(26, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_27(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(27, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_28(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(28, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_29(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(29, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_30(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(30, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_31(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(31, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_32(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(32, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_33(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(33, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_34(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(34, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_35(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(35, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_36(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(36, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_37(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(37, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_38(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(38, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_39(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(39, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_40(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(40, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_41(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(41, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_42(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(42, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_43(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(43, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_44(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(44, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_45(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(45, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_46(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(46, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_47(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(47, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_48(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(48, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_49(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(49, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_50(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(50, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_51(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(51, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_52(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(52, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_53(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(53, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_54(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(54, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_55(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(55, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_56(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(56, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_57(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(57, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_58(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(58, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_59(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(59, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_60(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(60, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_61(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(61, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_62(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(62, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_63(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(63, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_64(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(64, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_65(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(65, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_66(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(66, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_67(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(67, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_68(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(68, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_69(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(69, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_70(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(70, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_71(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(71, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_72(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(72, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_73(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(73, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_74(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(74, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_75(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(75, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_76(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(76, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_77(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(77, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_78(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(78, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_79(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(79, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_80(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(80, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_81(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(81, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_82(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(82, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_83(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(83, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_84(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(84, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_85(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(85, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_86(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(86, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_87(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(87, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_88(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(88, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_89(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(89, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_90(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(90, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_91(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(91, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_92(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(92, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_93(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(93, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_94(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(94, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_95(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(95, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_96(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(96, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_97(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(97, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_98(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(98, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_99(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(99, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_100(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(100, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_101(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(101, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_102(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(102, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_103(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(103, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_104(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(104, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_105(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(105, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_106(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(106, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_107(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(107, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_108(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(108, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_109(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(109, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_110(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(110, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_111(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(111, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_112(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(112, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_113(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(113, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_114(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(114, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_115(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(115, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_116(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(116, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_117(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(117, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_118(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(118, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_119(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(119, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_120(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(120, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_121(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(121, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_122(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(122, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_123(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(123, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_124(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(124, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_125(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(125, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_126(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(126, ints, floats, &r);
+  return argFloat(r);
+}
+
+static float callback_f_127(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(127, ints, floats, &r);
+  return argFloat(r);
+}
+
+static double callback_d_0(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(0, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_1(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(1, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_2(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(2, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_3(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(3, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_4(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(4, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_5(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(5, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_6(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(6, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_7(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(7, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_8(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(8, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_9(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(9, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_10(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(10, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_11(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(11, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_12(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(12, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_13(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(13, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_14(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(14, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_15(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(15, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_16(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(16, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_17(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(17, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_18(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(18, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_19(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(19, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_20(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(20, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_21(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(21, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_22(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(22, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_23(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(23, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_24(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(24, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_25(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(25, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_26(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(26, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_27(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(27, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_28(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(28, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_29(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(29, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_30(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(30, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_31(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(31, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_32(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(32, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_33(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(33, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_34(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(34, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_35(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(35, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_36(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(36, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_37(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(37, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_38(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(38, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_39(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(39, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_40(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(40, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_41(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(41, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_42(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(42, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_43(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(43, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_44(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(44, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_45(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(45, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_46(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(46, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_47(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(47, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_48(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(48, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_49(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(49, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_50(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(50, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_51(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(51, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_52(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(52, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_53(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(53, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_54(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(54, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_55(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(55, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_56(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(56, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_57(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(57, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_58(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(58, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_59(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(59, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_60(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(60, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_61(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(61, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_62(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(62, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_63(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(63, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_64(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(64, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_65(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(65, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_66(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(66, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_67(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(67, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_68(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(68, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_69(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(69, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_70(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(70, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_71(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(71, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_72(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(72, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_73(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(73, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_74(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(74, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_75(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(75, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_76(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(76, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_77(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(77, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_78(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(78, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_79(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(79, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_80(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(80, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_81(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(81, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_82(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(82, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_83(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(83, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_84(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(84, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_85(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(85, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_86(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(86, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_87(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(87, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_88(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(88, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_89(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(89, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_90(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(90, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_91(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(91, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_92(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(92, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_93(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(93, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_94(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(94, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_95(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(95, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_96(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(96, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_97(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(97, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_98(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(98, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_99(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(99, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_100(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(100, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_101(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(101, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_102(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(102, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_103(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(103, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_104(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(104, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_105(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(105, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_106(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(106, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_107(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(107, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_108(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(108, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_109(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(109, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_110(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(110, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_111(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(111, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_112(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(112, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_113(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(113, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_114(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(114, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_115(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(115, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_116(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(116, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_117(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(117, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_118(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(118, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_119(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(119, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_120(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(120, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_121(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(121, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_122(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(122, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_123(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(123, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_124(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(124, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_125(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(125, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_126(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(126, ints, floats, &r);
+  return argDouble(r);
+}
+
+static double callback_d_127(int64_t i0, int64_t i1, int64_t i2, int64_t i3, double f0, double f1, double f2, double f3) {
+  int64_t ints[] = {i0, i1, i2, i3};
+  double floats[] = {f0, f1, f2, f3};
+  uint64_t r = 0;
+  goCallback(127, ints, floats, &r);
+  return argDouble(r);
+}
+
+static void* const callbackStubs[4][DELEGATE_CALLBACK_SLOTS] = {
+  {
+    (void*)callback_v_0,
+    (void*)callback_v_1,
+    (void*)callback_v_2,
+    (void*)callback_v_3,
+    (void*)callback_v_4,
+    (void*)callback_v_5,
+    (void*)callback_v_6,
+    (void*)callback_v_7,
+    (void*)callback_v_8,
+    (void*)callback_v_9,
+    (void*)callback_v_10,
+    (void*)callback_v_11,
+    (void*)callback_v_12,
+    (void*)callback_v_13,
+    (void*)callback_v_14,
+    (void*)callback_v_15,
+    (void*)callback_v_16,
+    (void*)callback_v_17,
+    (void*)callback_v_18,
+    (void*)callback_v_19,
+    (void*)callback_v_20,
+    (void*)callback_v_21,
+    (void*)callback_v_22,
+    (void*)callback_v_23,
+    (void*)callback_v_24,
+    (void*)callback_v_25,
+    (void*)callback_v_26,
+    (void*)callback_v_27,
+    (void*)callback_v_28,
+    (void*)callback_v_29,
+    (void*)callback_v_30,
+    (void*)callback_v_31,
+    (void*)callback_v_32,
+    (void*)callback_v_33,
+    (void*)callback_v_34,
+    (void*)callback_v_35,
+    (void*)callback_v_36,
+    (void*)callback_v_37,
+    (void*)callback_v_38,
+    (void*)callback_v_39,
+    (void*)callback_v_40,
+    (void*)callback_v_41,
+    (void*)callback_v_42,
+    (void*)callback_v_43,
+    (void*)callback_v_44,
+    (void*)callback_v_45,
+    (void*)callback_v_46,
+    (void*)callback_v_47,
+    (void*)callback_v_48,
+    (void*)callback_v_49,
+    (void*)callback_v_50,
+    (void*)callback_v_51,
+    (void*)callback_v_52,
+    (void*)callback_v_53,
+    (void*)callback_v_54,
+    (void*)callback_v_55,
+    (void*)callback_v_56,
+    (void*)callback_v_57,
+    (void*)callback_v_58,
+    (void*)callback_v_59,
+    (void*)callback_v_60,
+    (void*)callback_v_61,
+    (void*)callback_v_62,
+    (void*)callback_v_63,
+    (void*)callback_v_64,
+    (void*)callback_v_65,
+    (void*)callback_v_66,
+    (void*)callback_v_67,
+    (void*)callback_v_68,
+    (void*)callback_v_69,
+    (void*)callback_v_70,
+    (void*)callback_v_71,
+    (void*)callback_v_72,
+    (void*)callback_v_73,
+    (void*)callback_v_74,
+    (void*)callback_v_75,
+    (void*)callback_v_76,
+    (void*)callback_v_77,
+    (void*)callback_v_78,
+    (void*)callback_v_79,
+    (void*)callback_v_80,
+    (void*)callback_v_81,
+    (void*)callback_v_82,
+    (void*)callback_v_83,
+    (void*)callback_v_84,
+    (void*)callback_v_85,
+    (void*)callback_v_86,
+    (void*)callback_v_87,
+    (void*)callback_v_88,
+    (void*)callback_v_89,
+    (void*)callback_v_90,
+    (void*)callback_v_91,
+    (void*)callback_v_92,
+    (void*)callback_v_93,
+    (void*)callback_v_94,
+    (void*)callback_v_95,
+    (void*)callback_v_96,
+    (void*)callback_v_97,
+    (void*)callback_v_98,
+    (void*)callback_v_99,
+    (void*)callback_v_100,
+    (void*)callback_v_101,
+    (void*)callback_v_102,
+    (void*)callback_v_103,
+    (void*)callback_v_104,
+    (void*)callback_v_105,
+    (void*)callback_v_106,
+    (void*)callback_v_107,
+    (void*)callback_v_108,
+    (void*)callback_v_109,
+    (void*)callback_v_110,
+    (void*)callback_v_111,
+    (void*)callback_v_112,
+    (void*)callback_v_113,
+    (void*)callback_v_114,
+    (void*)callback_v_115,
+    (void*)callback_v_116,
+    (void*)callback_v_117,
+    (void*)callback_v_118,
+    (void*)callback_v_119,
+    (void*)callback_v_120,
+    (void*)callback_v_121,
+    (void*)callback_v_122,
+    (void*)callback_v_123,
+    (void*)callback_v_124,
+    (void*)callback_v_125,
+    (void*)callback_v_126,
+    (void*)callback_v_127,
+  },
+  {
+    (void*)callback_i_0,
+    (void*)callback_i_1,
+    (void*)callback_i_2,
+    (void*)callback_i_3,
+    (void*)callback_i_4,
+    (void*)callback_i_5,
+    (void*)callback_i_6,
+    (void*)callback_i_7,
+    (void*)callback_i_8,
+    (void*)callback_i_9,
+    (void*)callback_i_10,
+    (void*)callback_i_11,
+    (void*)callback_i_12,
+    (void*)callback_i_13,
+    (void*)callback_i_14,
+    (void*)callback_i_15,
+    (void*)callback_i_16,
+    (void*)callback_i_17,
+    (void*)callback_i_18,
+    (void*)callback_i_19,
+    (void*)callback_i_20,
+    (void*)callback_i_21,
+    (void*)callback_i_22,
+    (void*)callback_i_23,
+    (void*)callback_i_24,
+    (void*)callback_i_25,
+    (void*)callback_i_26,
+    (void*)callback_i_27,
+    (void*)callback_i_28,
+    (void*)callback_i_29,
+    (void*)callback_i_30,
+    (void*)callback_i_31,
+    (void*)callback_i_32,
+    (void*)callback_i_33,
+    (void*)callback_i_34,
+    (void*)callback_i_35,
+    (void*)callback_i_36,
+    (void*)callback_i_37,
+    (void*)callback_i_38,
+    (void*)callback_i_39,
+    (void*)callback_i_40,
+    (void*)callback_i_41,
+    (void*)callback_i_42,
+    (void*)callback_i_43,
+    (void*)callback_i_44,
+    (void*)callback_i_45,
+    (void*)callback_i_46,
+    (void*)callback_i_47,
+    (void*)callback_i_48,
+    (void*)callback_i_49,
+    (void*)callback_i_50,
+    (void*)callback_i_51,
+    (void*)callback_i_52,
+    (void*)callback_i_53,
+    (void*)callback_i_54,
+    (void*)callback_i_55,
+    (void*)callback_i_56,
+    (void*)callback_i_57,
+    (void*)callback_i_58,
+    (void*)callback_i_59,
+    (void*)callback_i_60,
+    (void*)callback_i_61,
+    (void*)callback_i_62,
+    (void*)callback_i_63,
+    (void*)callback_i_64,
+    (void*)callback_i_65,
+    (void*)callback_i_66,
+    (void*)callback_i_67,
+    (void*)callback_i_68,
+    (void*)callback_i_69,
+    (void*)callback_i_70,
+    (void*)callback_i_71,
+    (void*)callback_i_72,
+    (void*)callback_i_73,
+    (void*)callback_i_74,
+    (void*)callback_i_75,
+    (void*)callback_i_76,
+    (void*)callback_i_77,
+    (void*)callback_i_78,
+    (void*)callback_i_79,
+    (void*)callback_i_80,
+    (void*)callback_i_81,
+    (void*)callback_i_82,
+    (void*)callback_i_83,
+    (void*)callback_i_84,
+    (void*)callback_i_85,
+    (void*)callback_i_86,
+    (void*)callback_i_87,
+    (void*)callback_i_88,
+    (void*)callback_i_89,
+    (void*)callback_i_90,
+    (void*)callback_i_91,
+    (void*)callback_i_92,
+    (void*)callback_i_93,
+    (void*)callback_i_94,
+    (void*)callback_i_95,
+    (void*)callback_i_96,
+    (void*)callback_i_97,
+    (void*)callback_i_98,
+    (void*)callback_i_99,
+    (void*)callback_i_100,
+    (void*)callback_i_101,
+    (void*)callback_i_102,
+    (void*)callback_i_103,
+    (void*)callback_i_104,
+    (void*)callback_i_105,
+    (void*)callback_i_106,
+    (void*)callback_i_107,
+    (void*)callback_i_108,
+    (void*)callback_i_109,
+    (void*)callback_i_110,
+    (void*)callback_i_111,
+    (void*)callback_i_112,
+    (void*)callback_i_113,
+    (void*)callback_i_114,
+    (void*)callback_i_115,
+    (void*)callback_i_116,
+    (void*)callback_i_117,
+    (void*)callback_i_118,
+    (void*)callback_i_119,
+    (void*)callback_i_120,
+    (void*)callback_i_121,
+    (void*)callback_i_122,
+    (void*)callback_i_123,
+    (void*)callback_i_124,
+    (void*)callback_i_125,
+    (void*)callback_i_126,
+    (void*)callback_i_127,
+  },
+  {
+    (void*)callback_f_0,
+    (void*)callback_f_1,
+    (void*)callback_f_2,
+    (void*)callback_f_3,
+    (void*)callback_f_4,
+    (void*)callback_f_5,
+    (void*)callback_f_6,
+    (void*)callback_f_7,
+    (void*)callback_f_8,
+    (void*)callback_f_9,
+    (void*)callback_f_10,
+    (void*)callback_f_11,
+    (void*)callback_f_12,
+    (void*)callback_f_13,
+    (void*)callback_f_14,
+    (void*)callback_f_15,
+    (void*)callback_f_16,
+    (void*)callback_f_17,
+    (void*)callback_f_18,
+    (void*)callback_f_19,
+    (void*)callback_f_20,
+    (void*)callback_f_21,
+    (void*)callback_f_22,
+    (void*)callback_f_23,
+    (void*)callback_f_24,
+    (void*)callback_f_25,
+    (void*)callback_f_26,
+    (void*)callback_f_27,
+    (void*)callback_f_28,
+    (void*)callback_f_29,
+    (void*)callback_f_30,
+    (void*)callback_f_31,
+    (void*)callback_f_32,
+    (void*)callback_f_33,
+    (void*)callback_f_34,
+    (void*)callback_f_35,
+    (void*)callback_f_36,
+    (void*)callback_f_37,
+    (void*)callback_f_38,
+    (void*)callback_f_39,
+    (void*)callback_f_40,
+    (void*)callback_f_41,
+    (void*)callback_f_42,
+    (void*)callback_f_43,
+    (void*)callback_f_44,
+    (void*)callback_f_45,
+    (void*)callback_f_46,
+    (void*)callback_f_47,
+    (void*)callback_f_48,
+    (void*)callback_f_49,
+    (void*)callback_f_50,
+    (void*)callback_f_51,
+    (void*)callback_f_52,
+    (void*)callback_f_53,
+    (void*)callback_f_54,
+    (void*)callback_f_55,
+    (void*)callback_f_56,
+    (void*)callback_f_57,
+    (void*)callback_f_58,
+    (void*)callback_f_59,
+    (void*)callback_f_60,
+    (void*)callback_f_61,
+    (void*)callback_f_62,
+    (void*)callback_f_63,
+    (void*)callback_f_64,
+    (void*)callback_f_65,
+    (void*)callback_f_66,
+    (void*)callback_f_67,
+    (void*)callback_f_68,
+    (void*)callback_f_69,
+    (void*)callback_f_70,
+    (void*)callback_f_71,
+    (void*)callback_f_72,
+    (void*)callback_f_73,
+    (void*)callback_f_74,
+    (void*)callback_f_75,
+    (void*)callback_f_76,
+    (void*)callback_f_77,
+    (void*)callback_f_78,
+    (void*)callback_f_79,
+    (void*)callback_f_80,
+    (void*)callback_f_81,
+    (void*)callback_f_82,
+    (void*)callback_f_83,
+    (void*)callback_f_84,
+    (void*)callback_f_85,
+    (void*)callback_f_86,
+    (void*)callback_f_87,
+    (void*)callback_f_88,
+    (void*)callback_f_89,
+    (void*)callback_f_90,
+    (void*)callback_f_91,
+    (void*)callback_f_92,
+    (void*)callback_f_93,
+    (void*)callback_f_94,
+    (void*)callback_f_95,
+    (void*)callback_f_96,
+    (void*)callback_f_97,
+    (void*)callback_f_98,
+    (void*)callback_f_99,
+    (void*)callback_f_100,
+    (void*)callback_f_101,
+    (void*)callback_f_102,
+    (void*)callback_f_103,
+    (void*)callback_f_104,
+    (void*)callback_f_105,
+    (void*)callback_f_106,
+    (void*)callback_f_107,
+    (void*)callback_f_108,
+    (void*)callback_f_109,
+    (void*)callback_f_110,
+    (void*)callback_f_111,
+    (void*)callback_f_112,
+    (void*)callback_f_113,
+    (void*)callback_f_114,
+    (void*)callback_f_115,
+    (void*)callback_f_116,
+    (void*)callback_f_117,
+    (void*)callback_f_118,
+    (void*)callback_f_119,
+    (void*)callback_f_120,
+    (void*)callback_f_121,
+    (void*)callback_f_122,
+    (void*)callback_f_123,
+    (void*)callback_f_124,
+    (void*)callback_f_125,
+    (void*)callback_f_126,
+    (void*)callback_f_127,
+  },
+  {
+    (void*)callback_d_0,
+    (void*)callback_d_1,
+    (void*)callback_d_2,
+    (void*)callback_d_3,
+    (void*)callback_d_4,
+    (void*)callback_d_5,
+    (void*)callback_d_6,
+    (void*)callback_d_7,
+    (void*)callback_d_8,
+    (void*)callback_d_9,
+    (void*)callback_d_10,
+    (void*)callback_d_11,
+    (void*)callback_d_12,
+    (void*)callback_d_13,
+    (void*)callback_d_14,
+    (void*)callback_d_15,
+    (void*)callback_d_16,
+    (void*)callback_d_17,
+    (void*)callback_d_18,
+    (void*)callback_d_19,
+    (void*)callback_d_20,
+    (void*)callback_d_21,
+    (void*)callback_d_22,
+    (void*)callback_d_23,
+    (void*)callback_d_24,
+    (void*)callback_d_25,
+    (void*)callback_d_26,
+    (void*)callback_d_27,
+    (void*)callback_d_28,
+    (void*)callback_d_29,
+    (void*)callback_d_30,
+    (void*)callback_d_31,
+    (void*)callback_d_32,
+    (void*)callback_d_33,
+    (void*)callback_d_34,
+    (void*)callback_d_35,
+    (void*)callback_d_36,
+    (void*)callback_d_37,
+    (void*)callback_d_38,
+    (void*)callback_d_39,
+    (void*)callback_d_40,
+    (void*)callback_d_41,
+    (void*)callback_d_42,
+    (void*)callback_d_43,
+    (void*)callback_d_44,
+    (void*)callback_d_45,
+    (void*)callback_d_46,
+    (void*)callback_d_47,
+    (void*)callback_d_48,
+    (void*)callback_d_49,
+    (void*)callback_d_50,
+    (void*)callback_d_51,
+    (void*)callback_d_52,
+    (void*)callback_d_53,
+    (void*)callback_d_54,
+    (void*)callback_d_55,
+    (void*)callback_d_56,
+    (void*)callback_d_57,
+    (void*)callback_d_58,
+    (void*)callback_d_59,
+    (void*)callback_d_60,
+    (void*)callback_d_61,
+    (void*)callback_d_62,
+    (void*)callback_d_63,
+    (void*)callback_d_64,
+    (void*)callback_d_65,
+    (void*)callback_d_66,
+    (void*)callback_d_67,
+    (void*)callback_d_68,
+    (void*)callback_d_69,
+    (void*)callback_d_70,
+    (void*)callback_d_71,
+    (void*)callback_d_72,
+    (void*)callback_d_73,
+    (void*)callback_d_74,
+    (void*)callback_d_75,
+    (void*)callback_d_76,
+    (void*)callback_d_77,
+    (void*)callback_d_78,
+    (void*)callback_d_79,
+    (void*)callback_d_80,
+    (void*)callback_d_81,
+    (void*)callback_d_82,
+    (void*)callback_d_83,
+    (void*)callback_d_84,
+    (void*)callback_d_85,
+    (void*)callback_d_86,
+    (void*)callback_d_87,
+    (void*)callback_d_88,
+    (void*)callback_d_89,
+    (void*)callback_d_90,
+    (void*)callback_d_91,
+    (void*)callback_d_92,
+    (void*)callback_d_93,
+    (void*)callback_d_94,
+    (void*)callback_d_95,
+    (void*)callback_d_96,
+    (void*)callback_d_97,
+    (void*)callback_d_98,
+    (void*)callback_d_99,
+    (void*)callback_d_100,
+    (void*)callback_d_101,
+    (void*)callback_d_102,
+    (void*)callback_d_103,
+    (void*)callback_d_104,
+    (void*)callback_d_105,
+    (void*)callback_d_106,
+    (void*)callback_d_107,
+    (void*)callback_d_108,
+    (void*)callback_d_109,
+    (void*)callback_d_110,
+    (void*)callback_d_111,
+    (void*)callback_d_112,
+    (void*)callback_d_113,
+    (void*)callback_d_114,
+    (void*)callback_d_115,
+    (void*)callback_d_116,
+    (void*)callback_d_117,
+    (void*)callback_d_118,
+    (void*)callback_d_119,
+    (void*)callback_d_120,
+    (void*)callback_d_121,
+    (void*)callback_d_122,
+    (void*)callback_d_123,
+    (void*)callback_d_124,
+    (void*)callback_d_125,
+    (void*)callback_d_126,
+    (void*)callback_d_127,
+  },
+};
+
+void* callbackStub(int resultClass, int slot) {
+  return callbackStubs[resultClass][slot];
+}
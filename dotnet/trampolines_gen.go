@@ -0,0 +1,225 @@
+//go:build ignore
+// +build ignore
+
+// trampolines_gen.go generates trampolines.c and trampolines.h, the C functions used by
+// (*Delegate).Call to invoke a native function pointer with any supported signature,
+// and the callback stubs returned by (*Runtime).NewCallback.
+//
+// Every argument and result belongs to a class: integers and pointers are passed as int64_t,
+// float32 as float and float64 as double. Each trampoline casts the function pointer to one
+// combination of classes, reads the arguments from an uint64_t array and stores the result.
+//
+// Callback stubs take maxArity integer and maxArity double parameters: the System V AMD64 and AArch64
+// calling conventions assign integer and floating point arguments to separate registers, in order,
+// so a stub receives any supported signature and goCallback picks the arguments it declares.
+// A float32 argument is read from the low 32 bits of a double. There's one stub per result class
+// and callback slot.
+//
+// Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// maxArity is the maximum number of delegate arguments.
+const maxArity = 4
+
+// nativeArity is the maximum number of trampoline arguments: the delegates created by the helper
+// assembly take an extra pointer that receives the managed exceptions.
+const nativeArity = maxArity + 1
+
+// callbackSlots is the maximum number of registered callbacks.
+const callbackSlots = 128
+
+type class struct {
+	// code is used in the trampoline names.
+	code byte
+	// cType is the C type used to call the function pointer.
+	cType string
+	// arg converts the uint64_t argument a[i] to cType.
+	arg string
+	// store saves the value v in *r.
+	store string
+}
+
+var (
+	argClasses = []class{
+		{code: 'i', cType: "int64_t", arg: "(int64_t)a[%d]", store: "*r = (uint64_t)v;"},
+		{code: 'f', cType: "float", arg: "argFloat(a[%d])", store: "*r = resultFloat(v);"},
+		{code: 'd', cType: "double", arg: "argDouble(a[%d])", store: "*r = resultDouble(v);"},
+	}
+	resultClasses = append([]class{{code: 'v', cType: "void"}}, argClasses...)
+)
+
+// combinations returns every combination of n argument classes, in the order
+// used by signature.trampoline in signature.go: the first argument is the least significant digit.
+func combinations(n int) [][]class {
+	if n == 0 {
+		return [][]class{nil}
+	}
+	var result [][]class
+	for _, rest := range combinations(n - 1) {
+		for _, c := range argClasses {
+			result = append(result, append([]class{c}, rest...))
+		}
+	}
+	return result
+}
+
+func main() {
+	var source, table bytes.Buffer
+	count := 0
+	fmt.Fprint(&source, header)
+	fmt.Fprint(&source, `#include <stdlib.h>
+#include <string.h>
+#include "trampolines.h"
+#include "_cgo_export.h"
+
+typedef void (*trampoline)(void* f, const uint64_t* a, uint64_t* r);
+
+static float argFloat(uint64_t a) {
+  uint32_t bits = (uint32_t)a;
+  float v;
+  memcpy(&v, &bits, sizeof(v));
+  return v;
+}
+
+static double argDouble(uint64_t a) {
+  double v;
+  memcpy(&v, &a, sizeof(v));
+  return v;
+}
+
+static uint64_t resultFloat(float v) {
+  uint32_t bits;
+  memcpy(&bits, &v, sizeof(v));
+  return bits;
+}
+
+static uint64_t resultDouble(double v) {
+  uint64_t bits;
+  memcpy(&bits, &v, sizeof(v));
+  return bits;
+}
+`)
+	for n := 0; n <= nativeArity; n++ {
+		for _, args := range combinations(n) {
+			var codes strings.Builder
+			types := make([]string, len(args))
+			values := make([]string, len(args))
+			for i, c := range args {
+				codes.WriteByte(c.code)
+				types[i] = c.cType
+				values[i] = fmt.Sprintf(c.arg, i)
+			}
+			if len(args) == 0 {
+				types = []string{"void"}
+			}
+			for _, result := range resultClasses {
+				name := fmt.Sprintf("trampoline_%c_%s", result.code, codes.String())
+				call := fmt.Sprintf("((%s (*)(%s))f)(%s)", result.cType, strings.Join(types, ", "), strings.Join(values, ", "))
+				fmt.Fprintf(&source, "\nstatic void %s(void* f, const uint64_t* a, uint64_t* r) {\n", name)
+				if result.code == 'v' {
+					fmt.Fprintf(&source, "  %s;\n", call)
+				} else {
+					fmt.Fprintf(&source, "  %s v = %s;\n  %s\n", result.cType, call, result.store)
+				}
+				fmt.Fprint(&source, "}\n")
+				fmt.Fprintf(&table, "  %s,\n", name)
+				count++
+			}
+		}
+	}
+	fmt.Fprintf(&source, "\nstatic const trampoline trampolines[DELEGATE_TRAMPOLINES] = {\n%s};\n", table.String())
+	fmt.Fprint(&source, `
+void* callDelegate(void* f, int index, const uint64_t* args, int exceptionArg, uint64_t* result) {
+  if (exceptionArg < 0) {
+    trampolines[index](f, args, result);
+    return NULL;
+  }
+  uint64_t a[DELEGATE_NATIVE_ARITY];
+  void* exception = NULL;
+  memcpy(a, args, sizeof(a));
+  a[exceptionArg] = (uint64_t)(uintptr_t)&exception;
+  trampolines[index](f, a, result);
+  return exception;
+}
+`)
+
+	ints := make([]string, maxArity)
+	floats := make([]string, maxArity)
+	params := make([]string, 0, 2*maxArity)
+	for i := range ints {
+		ints[i] = fmt.Sprintf("i%d", i)
+		params = append(params, "int64_t "+ints[i])
+	}
+	for i := range floats {
+		floats[i] = fmt.Sprintf("f%d", i)
+		params = append(params, "double "+floats[i])
+	}
+	var stubs bytes.Buffer
+	for _, result := range resultClasses {
+		fmt.Fprintf(&stubs, "  {\n")
+		for slot := 0; slot < callbackSlots; slot++ {
+			name := fmt.Sprintf("callback_%c_%d", result.code, slot)
+			fmt.Fprintf(&source, "\nstatic %s %s(%s) {\n", result.cType, name, strings.Join(params, ", "))
+			fmt.Fprintf(&source, "  int64_t ints[] = {%s};\n", strings.Join(ints, ", "))
+			fmt.Fprintf(&source, "  double floats[] = {%s};\n", strings.Join(floats, ", "))
+			fmt.Fprintf(&source, "  uint64_t r = 0;\n  goCallback(%d, ints, floats, &r);\n", slot)
+			switch result.code {
+			case 'i':
+				fmt.Fprint(&source, "  return (int64_t)r;\n")
+			case 'f':
+				fmt.Fprint(&source, "  return argFloat(r);\n")
+			case 'd':
+				fmt.Fprint(&source, "  return argDouble(r);\n")
+			}
+			fmt.Fprint(&source, "}\n")
+			fmt.Fprintf(&stubs, "    (void*)%s,\n", name)
+		}
+		fmt.Fprintf(&stubs, "  },\n")
+	}
+	fmt.Fprintf(&source, "\nstatic void* const callbackStubs[%d][DELEGATE_CALLBACK_SLOTS] = {\n%s};\n", len(resultClasses), stubs.String())
+	fmt.Fprint(&source, `
+void* callbackStub(int resultClass, int slot) {
+  return callbackStubs[resultClass][slot];
+}
+`)
+
+	var h bytes.Buffer
+	fmt.Fprint(&h, header)
+	fmt.Fprintf(&h, `#pragma once
+
+#include <stdint.h>
+
+// DELEGATE_MAX_ARITY is the maximum number of delegate arguments, DELEGATE_NATIVE_ARITY
+// the maximum number of arguments supported by callDelegate.
+#define DELEGATE_MAX_ARITY %d
+#define DELEGATE_NATIVE_ARITY %d
+#define DELEGATE_TRAMPOLINES %d
+#define DELEGATE_CALLBACK_SLOTS %d
+
+// callDelegate calls f with the trampoline at index, args holds DELEGATE_NATIVE_ARITY values.
+// Unless exceptionArg is negative, the argument at exceptionArg is replaced by a pointer to a void*
+// that f may set, it's returned.
+void* callDelegate(void* f, int index, const uint64_t* args, int exceptionArg, uint64_t* result);
+
+// callbackStub returns the callback stub of a slot, calling it calls goCallback with the slot,
+// DELEGATE_MAX_ARITY integer arguments and DELEGATE_MAX_ARITY floating point arguments.
+void* callbackStub(int resultClass, int slot);
+`, maxArity, nativeArity, count, callbackSlots)
+
+	if err := ioutil.WriteFile("trampolines.c", source.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("trampolines.h", h.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+const header = "// Code generated by trampolines_gen.go; DO NOT EDIT.\n\n"